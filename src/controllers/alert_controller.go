@@ -0,0 +1,203 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+	"inventory-service/src/services"
+	"inventory-service/src/utils"
+)
+
+// AlertRuleRequest defines the payload to create or replace an alert rule.
+type AlertRuleRequest struct {
+	Name      string  `json:"name" binding:"required,max=255" example:"Low laptop stock"`
+	ItemID    *string `json:"item_id" example:"5d3c0a2e-7b1f-4e8a-9c6d-1f2e3a4b5c6d"`
+	Threshold *int    `json:"threshold" binding:"required,min=0" example:"5"`
+	Enabled   *bool   `json:"enabled" example:"true"`
+}
+
+// GetAlerts handles GET /alerts requests and returns fired alerts.
+// @Summary List alerts
+// @Description Retrieve low_stock, out_of_stock and recovered alerts, newest first.
+// @Tags alerts
+// @Accept json
+// @Produce json
+// @Param item_id query string false "Filter by item ID"
+// @Param rule_id query string false "Filter by rule ID"
+// @Param event query string false "Filter by event (low_stock|out_of_stock|recovered)"
+// @Param from query string false "Only alerts at or after this RFC 3339 time"
+// @Param to query string false "Only alerts before this RFC 3339 time"
+// @Param limit query int false "Alerts per page (default 10, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {array} models.Alert
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /alerts [get]
+func GetAlerts(c *gin.Context) {
+	db := utils.ConnectDatabase()
+	query := db.Model(&models.Alert{})
+
+	if itemID := c.Query("item_id"); itemID != "" {
+		query = query.Where("item_id = ?", itemID)
+	}
+	if ruleID := c.Query("rule_id"); ruleID != "" {
+		query = query.Where("rule_id = ?", ruleID)
+	}
+	if event := c.Query("event"); event != "" {
+		query = query.Where("event = ?", event)
+	}
+	if fromStr := c.Query("from"); fromStr != "" {
+		from, err := time.Parse(time.RFC3339, fromStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "from must be an RFC 3339 time"})
+			return
+		}
+		query = query.Where("created_at >= ?", from)
+	}
+	if toStr := c.Query("to"); toStr != "" {
+		to, err := time.Parse(time.RFC3339, toStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "to must be an RFC 3339 time"})
+			return
+		}
+		query = query.Where("created_at < ?", to)
+	}
+
+	limit, offset := paginate(c)
+
+	var alerts []models.Alert
+	if err := query.Order("created_at desc").Limit(limit).Offset(offset).Find(&alerts).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, alerts)
+}
+
+// GetAlertRules handles GET /alerts/rules requests and returns all alert rules.
+// @Summary List alert rules
+// @Description Retrieve the stock threshold rules, global rules first.
+// @Tags alerts
+// @Accept json
+// @Produce json
+// @Param item_id query string false "Only rules for this item"
+// @Success 200 {array} models.AlertRule
+// @Failure 500 {object} map[string]string
+// @Router /alerts/rules [get]
+func GetAlertRules(c *gin.Context) {
+	db := utils.ConnectDatabase()
+	query := db.Model(&models.AlertRule{})
+	if itemID := c.Query("item_id"); itemID != "" {
+		query = query.Where("item_id = ?", itemID)
+	}
+
+	var rules []models.AlertRule
+	if err := query.Order("item_id NULLS FIRST, threshold desc").Find(&rules).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, rules)
+}
+
+// CreateAlertRule handles POST /alerts/rules requests to add a stock threshold rule.
+// @Summary Create an alert rule
+// @Description Alert when available stock (stock minus reserved) falls to or below threshold, again when it reaches zero and once more when it recovers, for one item or, without item_id, for every item. Items already below the threshold do not alert until their level changes. Rules are enabled unless enabled is false.
+// @Tags alerts
+// @Accept json
+// @Produce json
+// @Param rule body AlertRuleRequest true "Rule to create"
+// @Success 201 {object} models.AlertRule
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /alerts/rules [post]
+func CreateAlertRule(c *gin.Context) {
+	var input AlertRuleRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var rule models.AlertRule
+	saveAlertRule(c, &rule, input, http.StatusCreated)
+}
+
+// UpdateAlertRule handles PUT /alerts/rules/:id requests to replace a rule.
+// @Summary Replace an alert rule
+// @Description Replace a rule. Items are re-levelled against the new threshold without alerting, so they alert when their level next changes.
+// @Tags alerts
+// @Accept json
+// @Produce json
+// @Param id path string true "Rule ID"
+// @Param rule body AlertRuleRequest true "New rule"
+// @Success 200 {object} models.AlertRule
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /alerts/rules/{id} [put]
+func UpdateAlertRule(c *gin.Context) {
+	var rule models.AlertRule
+	db := utils.ConnectDatabase()
+	if err := db.First(&rule, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "alert rule not found"})
+		return
+	}
+
+	var input AlertRuleRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	saveAlertRule(c, &rule, input, http.StatusOK)
+}
+
+// DeleteAlertRule handles DELETE /alerts/rules/:id requests to remove a rule.
+// @Summary Delete an alert rule
+// @Description Remove a rule. Alerts it already fired are kept.
+// @Tags alerts
+// @Accept json
+// @Produce json
+// @Param id path string true "Rule ID"
+// @Success 204 {string} string "No Content"
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /alerts/rules/{id} [delete]
+func DeleteAlertRule(c *gin.Context) {
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return services.DeleteAlertRule(tx, c.Param("id"))
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func saveAlertRule(c *gin.Context, rule *models.AlertRule, input AlertRuleRequest, status int) {
+	rule.Name = input.Name
+	rule.ItemID = input.ItemID
+	if input.ItemID != nil && *input.ItemID == "" {
+		rule.ItemID = nil
+	}
+	rule.Threshold = *input.Threshold
+	rule.Enabled = input.Enabled == nil || *input.Enabled
+
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return services.SaveAlertRule(tx, rule)
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(status, rule)
+}
@@ -0,0 +1,168 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+	"inventory-service/src/services"
+	"inventory-service/src/utils"
+)
+
+// AttributeSchemaRequest defines the payload to create or replace an attribute schema.
+type AttributeSchemaRequest struct {
+	Name        string                  `json:"name" binding:"required,max=64" example:"apparel"`
+	Description string                  `json:"description" example:"Clothing sizes and colours"`
+	Fields      []models.AttributeField `json:"fields" binding:"dive"`
+}
+
+// GetAttributeSchemas handles GET /attribute-schemas requests and returns all schemas.
+// @Summary List attribute schemas
+// @Description Retrieve the named sets of typed custom attributes items can be assigned to, ordered by name.
+// @Tags attributes
+// @Accept json
+// @Produce json
+// @Param limit query int false "Schemas per page (default 10, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {array} models.AttributeSchema
+// @Failure 500 {object} map[string]string
+// @Router /attribute-schemas [get]
+func GetAttributeSchemas(c *gin.Context) {
+	limit, offset := paginate(c)
+
+	var schemas []models.AttributeSchema
+	db := utils.ConnectDatabase()
+	if err := db.Order("name asc").Limit(limit).Offset(offset).Find(&schemas).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, schemas)
+}
+
+// GetAttributeSchemaByID handles GET /attribute-schemas/:id requests and returns the matching schema.
+// @Summary Get an attribute schema
+// @Description Retrieve a single attribute schema by its identifier.
+// @Tags attributes
+// @Accept json
+// @Produce json
+// @Param id path string true "Schema ID"
+// @Success 200 {object} models.AttributeSchema
+// @Failure 404 {object} map[string]string
+// @Router /attribute-schemas/{id} [get]
+func GetAttributeSchemaByID(c *gin.Context) {
+	var schema models.AttributeSchema
+	db := utils.ConnectDatabase()
+	if err := db.First(&schema, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "attribute schema not found"})
+		return
+	}
+	c.JSON(http.StatusOK, schema)
+}
+
+// CreateAttributeSchema handles POST /attribute-schemas requests to add a new schema.
+// @Summary Create an attribute schema
+// @Description Create a named schema of typed attributes (string, number, integer, boolean or enum) with optional required flags and numeric bounds.
+// @Tags attributes
+// @Accept json
+// @Produce json
+// @Param schema body AttributeSchemaRequest true "Schema to create"
+// @Success 201 {object} models.AttributeSchema
+// @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /attribute-schemas [post]
+func CreateAttributeSchema(c *gin.Context) {
+	var input AttributeSchemaRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	schema := models.AttributeSchema{
+		Name:        input.Name,
+		Description: input.Description,
+		Fields:      input.Fields,
+	}
+
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return services.SaveAttributeSchema(tx, &schema)
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, schema)
+}
+
+// UpdateAttributeSchema handles PUT /attribute-schemas/:id requests to replace a schema.
+// @Summary Replace an attribute schema
+// @Description Replace the name, description and fields of a schema. The change is rejected if an item assigned to the schema would no longer be valid.
+// @Tags attributes
+// @Accept json
+// @Produce json
+// @Param id path string true "Schema ID"
+// @Param schema body AttributeSchemaRequest true "New schema"
+// @Success 200 {object} models.AttributeSchema
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /attribute-schemas/{id} [put]
+func UpdateAttributeSchema(c *gin.Context) {
+	var schema models.AttributeSchema
+	db := utils.ConnectDatabase()
+	if err := db.First(&schema, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "attribute schema not found"})
+		return
+	}
+
+	var input AttributeSchemaRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	schema.Name = input.Name
+	schema.Description = input.Description
+	schema.Fields = input.Fields
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return services.SaveAttributeSchema(tx, &schema)
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, schema)
+}
+
+// DeleteAttributeSchema handles DELETE /attribute-schemas/:id requests to remove an unused schema.
+// @Summary Delete an attribute schema
+// @Description Remove a schema that no item, including items in the trash, is assigned to.
+// @Tags attributes
+// @Accept json
+// @Produce json
+// @Param id path string true "Schema ID"
+// @Success 204 {string} string "No Content"
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /attribute-schemas/{id} [delete]
+func DeleteAttributeSchema(c *gin.Context) {
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return services.DeleteAttributeSchema(tx, c.Param("id"))
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
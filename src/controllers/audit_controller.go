@@ -0,0 +1,109 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"inventory-service/src/models"
+	"inventory-service/src/utils"
+)
+
+// GetItemHistory handles GET /inventory/:id/history requests and returns the item's audit trail.
+// @Summary Get item history
+// @Description Retrieve every recorded change to an item, newest first. History outlives purged items.
+// @Tags audit
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Param limit query int false "Entries per page (default 10, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {array} models.AuditEntry
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/{id}/history [get]
+func GetItemHistory(c *gin.Context) {
+	id := c.Param("id")
+	limit, offset := paginate(c)
+
+	var entries []models.AuditEntry
+	db := utils.ConnectDatabase()
+	err := db.Where("item_id = ?", id).
+		Order("created_at desc").
+		Limit(limit).Offset(offset).
+		Find(&entries).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if len(entries) == 0 && offset == 0 {
+		var count int64
+		db.Unscoped().Model(&models.Item{}).Where("id = ?", id).Count(&count)
+		if count == 0 {
+			c.JSON(http.StatusNotFound, gin.H{"error": "item not found"})
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
+
+// SearchAudit handles GET /audit requests and searches the audit trail of all items.
+// @Summary Search the audit trail
+// @Description Retrieve audit entries filtered by actor, item, action and time range, newest first.
+// @Tags audit
+// @Accept json
+// @Produce json
+// @Param actor query string false "Filter by actor"
+// @Param item_id query string false "Filter by item ID"
+// @Param action query string false "Filter by action (create|update|delete|restore|purge)"
+// @Param from query string false "Only entries at or after this RFC 3339 time"
+// @Param to query string false "Only entries before this RFC 3339 time"
+// @Param limit query int false "Entries per page (default 10, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {array} models.AuditEntry
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /audit [get]
+func SearchAudit(c *gin.Context) {
+	db := utils.ConnectDatabase()
+	query := db.Model(&models.AuditEntry{})
+
+	if actor := c.Query("actor"); actor != "" {
+		query = query.Where("actor = ?", actor)
+	}
+	if itemID := c.Query("item_id"); itemID != "" {
+		query = query.Where("item_id = ?", itemID)
+	}
+	if action := c.Query("action"); action != "" {
+		query = query.Where("action = ?", action)
+	}
+	if fromStr := c.Query("from"); fromStr != "" {
+		from, err := time.Parse(time.RFC3339, fromStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "from must be an RFC 3339 time"})
+			return
+		}
+		query = query.Where("created_at >= ?", from)
+	}
+	if toStr := c.Query("to"); toStr != "" {
+		to, err := time.Parse(time.RFC3339, toStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "to must be an RFC 3339 time"})
+			return
+		}
+		query = query.Where("created_at < ?", to)
+	}
+
+	limit, offset := paginate(c)
+
+	var entries []models.AuditEntry
+	if err := query.Order("created_at desc").Limit(limit).Offset(offset).Find(&entries).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
@@ -0,0 +1,222 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+	"inventory-service/src/services"
+	"inventory-service/src/utils"
+)
+
+// CreateCategoryRequest defines the payload required to create a new category.
+type CreateCategoryRequest struct {
+	Name     string  `json:"name" binding:"required,max=255" example:"Headphones"`
+	ParentID *string `json:"parent_id" example:"4b1f6c1e-2d3a-4f5b-8c9d-0e1f2a3b4c5d"`
+}
+
+// UpdateCategoryRequest defines the fields that can be updated on a category.
+type UpdateCategoryRequest struct {
+	Name     *string `json:"name" binding:"omitempty,max=255" example:"Over-ear headphones"`
+	ParentID *string `json:"parent_id" example:"4b1f6c1e-2d3a-4f5b-8c9d-0e1f2a3b4c5d"`
+}
+
+// GetCategories handles GET /categories requests and returns categories.
+// @Summary List categories
+// @Description Retrieve categories ordered by name, optionally only the direct children of one category.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param parent_id query string false "Only subcategories of this category"
+// @Param limit query int false "Categories per page (default 10, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {array} models.Category
+// @Failure 500 {object} map[string]string
+// @Router /categories [get]
+func GetCategories(c *gin.Context) {
+	limit, offset := paginate(c)
+
+	db := utils.ConnectDatabase()
+	query := db.Model(&models.Category{})
+	if parentID := c.Query("parent_id"); parentID != "" {
+		query = query.Where("parent_id = ?", parentID)
+	}
+
+	var categories []models.Category
+	if err := query.Order("name asc").Limit(limit).Offset(offset).Find(&categories).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, categories)
+}
+
+// GetCategoryByID handles GET /categories/:id requests and returns the matching category.
+// @Summary Get a category
+// @Description Retrieve a single category by its identifier.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path string true "Category ID"
+// @Success 200 {object} models.Category
+// @Failure 404 {object} map[string]string
+// @Router /categories/{id} [get]
+func GetCategoryByID(c *gin.Context) {
+	var category models.Category
+	db := utils.ConnectDatabase()
+	if err := db.First(&category, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "category not found"})
+		return
+	}
+	c.JSON(http.StatusOK, category)
+}
+
+// CreateCategory handles POST /categories requests to add a new category.
+// @Summary Create a category
+// @Description Create a root category, or a subcategory when parent_id is given. Sibling names must be unique.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param category body CreateCategoryRequest true "Category to create"
+// @Success 201 {object} models.Category
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /categories [post]
+func CreateCategory(c *gin.Context) {
+	var input CreateCategoryRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	category := models.Category{Name: input.Name, ParentID: input.ParentID}
+
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return services.SaveCategory(tx, &category)
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, category)
+}
+
+// UpdateCategory handles PUT /categories/:id requests to rename or move a category.
+// @Summary Update a category
+// @Description Rename a category or move it under another parent; an empty parent_id makes it a root. A category cannot be moved under its own subtree.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path string true "Category ID"
+// @Param category body UpdateCategoryRequest true "Fields to update"
+// @Success 200 {object} models.Category
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /categories/{id} [put]
+func UpdateCategory(c *gin.Context) {
+	var category models.Category
+	db := utils.ConnectDatabase()
+	if err := db.First(&category, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "category not found"})
+		return
+	}
+
+	var payload UpdateCategoryRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if payload.Name != nil {
+		category.Name = *payload.Name
+	}
+	if payload.ParentID != nil {
+		category.ParentID = payload.ParentID
+		if *payload.ParentID == "" {
+			category.ParentID = nil
+		}
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return services.SaveCategory(tx, &category)
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, category)
+}
+
+// DeleteCategory handles DELETE /categories/:id requests to remove an empty category.
+// @Summary Delete a category
+// @Description Remove a category that has no subcategories and no items, including items in the trash.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path string true "Category ID"
+// @Success 204 {string} string "No Content"
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /categories/{id} [delete]
+func DeleteCategory(c *gin.Context) {
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return services.DeleteCategory(tx, c.Param("id"))
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetCategoryRollup handles GET /categories/rollup requests and summarises every category tree.
+// @Summary Roll up all categories
+// @Description Retrieve the category tree with the item count, total stock and stock value (per currency) of each subtree. Items in the trash are not counted.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Success 200 {array} services.CategoryRollup
+// @Failure 500 {object} map[string]string
+// @Router /categories/rollup [get]
+func GetCategoryRollup(c *gin.Context) {
+	db := utils.ConnectDatabase()
+	rollup, err := services.RollupCategories(db, "")
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, rollup)
+}
+
+// GetCategorySubtreeRollup handles GET /categories/:id/rollup requests and summarises one subtree.
+// @Summary Roll up a category
+// @Description Retrieve a category and its descendants with the item count, total stock and stock value (per currency) of each subtree.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path string true "Category ID"
+// @Success 200 {object} services.CategoryRollup
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /categories/{id}/rollup [get]
+func GetCategorySubtreeRollup(c *gin.Context) {
+	db := utils.ConnectDatabase()
+	rollup, err := services.RollupCategories(db, c.Param("id"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, rollup[0])
+}
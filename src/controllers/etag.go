@@ -0,0 +1,82 @@
+package controllers
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"inventory-service/src/models"
+)
+
+var (
+	errPreconditionFailed   = errors.New("item has been modified since it was read")
+	errPreconditionRequired = errors.New("If-Match header is required")
+)
+
+// itemETag derives a strong entity tag from the item's version.
+func itemETag(item models.Item) string {
+	return fmt.Sprintf(`"%d"`, item.Version)
+}
+
+// listETag derives a weak entity tag from the identity and version of every listed item.
+func listETag(items []models.Item) string {
+	hash := sha1.New()
+	for _, item := range items {
+		fmt.Fprintf(hash, "%s:%d;", item.ID, item.Version)
+	}
+	return `W/"` + hex.EncodeToString(hash.Sum(nil)) + `"`
+}
+
+// notModified sets the ETag header and reports whether If-None-Match already
+// holds it, in which case a 304 has been written.
+func notModified(c *gin.Context, etag string) bool {
+	c.Header("ETag", etag)
+	if header := c.GetHeader("If-None-Match"); header != "" && etagListMatches(header, etag, true) {
+		c.Status(http.StatusNotModified)
+		return true
+	}
+	return false
+}
+
+// checkIfMatch validates the If-Match header against the current item. Set
+// REQUIRE_IF_MATCH=true to reject writes that do not send one.
+func checkIfMatch(c *gin.Context, item models.Item) error {
+	header := c.GetHeader("If-Match")
+	if header == "" {
+		if os.Getenv("REQUIRE_IF_MATCH") == "true" {
+			return errPreconditionRequired
+		}
+		return nil
+	}
+	if !etagListMatches(header, itemETag(item), false) {
+		return errPreconditionFailed
+	}
+	return nil
+}
+
+// etagListMatches compares etag against a comma-separated If-Match/If-None-Match
+// header. If-None-Match uses weak comparison; If-Match requires strong tags.
+func etagListMatches(header, etag string, weak bool) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+		if weak {
+			if strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+			continue
+		}
+		if !strings.HasPrefix(candidate, "W/") && candidate == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,35 @@
+package controllers
+
+import "testing"
+
+func TestETagListMatches(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		etag   string
+		weak   bool
+		want   bool
+	}{
+		{"strong equal", `"3"`, `"3"`, false, true},
+		{"strong different", `"4"`, `"3"`, false, false},
+		{"strong in list", `"1", "3" ,"5"`, `"3"`, false, true},
+		{"strong rejects weak candidate", `W/"3"`, `"3"`, false, false},
+		{"strong rejects weak etag", `W/"3"`, `W/"3"`, false, false},
+		{"strong wildcard", `*`, `"3"`, false, true},
+		{"strong wildcard in list", `"1", *`, `"3"`, false, true},
+		{"weak equal", `W/"abc"`, `W/"abc"`, true, true},
+		{"weak matches strong candidate", `"abc"`, `W/"abc"`, true, true},
+		{"weak matches strong etag", `W/"3"`, `"3"`, true, true},
+		{"weak different", `W/"abd"`, `W/"abc"`, true, false},
+		{"weak in list", `W/"x", W/"abc"`, `W/"abc"`, true, true},
+		{"weak wildcard", `*`, `W/"abc"`, true, true},
+		{"unquoted does not match", `3`, `"3"`, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := etagListMatches(tt.header, tt.etag, tt.weak); got != tt.want {
+				t.Errorf("etagListMatches(%q, %q, %v) = %v, want %v", tt.header, tt.etag, tt.weak, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"inventory-service/src/labels"
+	"inventory-service/src/services"
+)
+
+// paginate reads limit and offset query params (with sane bounds).
+func paginate(c *gin.Context) (int, int) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil || limit < 1 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// asOfParam reads the optional as_of query param used for point-in-time reads.
+func asOfParam(c *gin.Context) (time.Time, bool, error) {
+	asOfStr := c.Query("as_of")
+	if asOfStr == "" {
+		return time.Time{}, false, nil
+	}
+	asOf, err := time.Parse(time.RFC3339, asOfStr)
+	if err != nil {
+		return time.Time{}, false, errors.New("as_of must be an RFC 3339 time")
+	}
+	return asOf, true, nil
+}
+
+// currentActor returns the caller identity set by middlewares.Identity.
+func currentActor(c *gin.Context) string {
+	if userID := c.GetString("user_id"); userID != "" {
+		return userID
+	}
+	return "anonymous"
+}
+
+// auditContext captures who is making the request and from where.
+func auditContext(c *gin.Context) services.AuditContext {
+	return services.AuditContext{
+		Actor:     currentActor(c),
+		RequestID: c.GetString("request_id"),
+		ClientIP:  c.ClientIP(),
+	}
+}
+
+// errorStatuses maps the errors of the HTTP layer itself onto status codes; the
+// rest are left to services.ErrorStatus.
+var errorStatuses = []struct {
+	err    error
+	status int
+}{
+	{labels.ErrUnsupported, http.StatusBadRequest},
+	{errPreconditionFailed, http.StatusPreconditionFailed},
+	{errPreconditionRequired, http.StatusPreconditionRequired},
+}
+
+// respondError writes err with the status code of the first matching error.
+func respondError(c *gin.Context, err error) {
+	status := services.ErrorStatus(err)
+	for _, candidate := range errorStatuses {
+		if errors.Is(err, candidate.err) {
+			status = candidate.status
+			break
+		}
+	}
+	c.JSON(status, gin.H{"error": err.Error()})
+}
@@ -1,28 +1,54 @@
 package controllers
 
 import (
-	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
 
 	"inventory-service/src/models"
+	"inventory-service/src/services"
 	"inventory-service/src/utils"
 )
 
 // CreateItemRequest defines the payload required to create a new inventory item.
 type CreateItemRequest struct {
-	Name  string  `json:"name" binding:"required" example:"Laptop"`
-	Stock int     `json:"stock" binding:"required" example:"10"`
-	Price float64 `json:"price" binding:"required" example:"999.99"`
+	SKU        string            `json:"sku" binding:"required" example:"LAPTOP-001"`
+	Barcode    *string           `json:"barcode" example:"4006381333931"`
+	Name       string            `json:"name" binding:"required" example:"Laptop"`
+	CategoryID *string           `json:"category_id" example:"4b1f6c1e-2d3a-4f5b-8c9d-0e1f2a3b4c5d"`
+	Tags       []string          `json:"tags" example:"wireless,clearance"`
+	Attributes models.Attributes `json:"attributes" swaggertype:"object"`
+	SchemaID   *string           `json:"attribute_schema_id" example:"9a7c2e4f-1b3d-4c5e-8f6a-7b8c9d0e1f2a"`
+	Stock      int               `json:"stock" binding:"required" example:"10"`
+	Price      *decimal.Decimal  `json:"price" binding:"required" swaggertype:"string" example:"999.99"`
+	Currency   string            `json:"currency" binding:"omitempty,iso4217" example:"USD"`
+
+	ReorderPoint    int `json:"reorder_point" binding:"min=0" example:"5"`
+	ReorderQuantity int `json:"reorder_quantity" binding:"min=0" example:"20"`
+	SafetyStock     int `json:"safety_stock" binding:"min=0" example:"2"`
 }
 
 // UpdateItemRequest defines the fields that can be updated on an inventory item.
 type UpdateItemRequest struct {
-	Name  *string  `json:"name" example:"Laptop Pro"`
-	Stock *int     `json:"stock" example:"15"`
-	Price *float64 `json:"price" example:"849.99"`
+	SKU        *string           `json:"sku" example:"LAPTOP-002"`
+	Barcode    *string           `json:"barcode" example:"4006381333931"`
+	Name       *string           `json:"name" example:"Laptop Pro"`
+	CategoryID *string           `json:"category_id" example:"4b1f6c1e-2d3a-4f5b-8c9d-0e1f2a3b4c5d"`
+	Tags       *[]string         `json:"tags" example:"wireless"`
+	Attributes models.Attributes `json:"attributes" swaggertype:"object"`
+	SchemaID   *string           `json:"attribute_schema_id" example:"9a7c2e4f-1b3d-4c5e-8f6a-7b8c9d0e1f2a"`
+	Stock      *int              `json:"stock" example:"15"`
+	Price      *decimal.Decimal  `json:"price" swaggertype:"string" example:"849.99"`
+	Currency   *string           `json:"currency" binding:"omitempty,iso4217" example:"EUR"`
+
+	ReorderPoint    *int `json:"reorder_point" binding:"omitempty,min=0" example:"8"`
+	ReorderQuantity *int `json:"reorder_quantity" binding:"omitempty,min=0" example:"24"`
+	SafetyStock     *int `json:"safety_stock" binding:"omitempty,min=0" example:"4"`
 }
 
 // GetItems handles GET /inventory requests and returns all inventory items.
@@ -32,100 +58,96 @@ type UpdateItemRequest struct {
 // @Accept json
 // @Produce json
 // @Param name query string false "Filter by item name (case-insensitive)"
-// @Param min_stock query int false "Minimum stock filter"
+// @Param currency query string false "Only items priced in this ISO 4217 currency"
+// @Param category query string false "Only items in this category (ID)"
+// @Param include_descendants query bool false "With category, also include items in its subcategories"
+// @Param tag query []string false "Only items with this tag (repeatable; all must match)" collectionFormat(multi)
+// @Param attr.name query string false "Only items whose attribute equals the value, e.g. attr.color=red; attr.name_gt, _gte, _lt and _lte compare numbers, e.g. attr.weight_gt=2"
+// @Param as_of query string false "List items as they were at this RFC 3339 time"
+// @Param location query string false "Only items stocked at this location (ID or code); not combinable with as_of"
+// @Param min_stock query int false "Minimum stock filter (per location when location is given)"
 // @Param limit query int false "Items per page (default 10, max 100)"
 // @Param offset query int false "Offset for pagination"
 // @Param sort_by query string false "Sort field (name|stock|price|created_at)"
 // @Param order query string false "Sort order (asc|desc)"
+// @Param If-None-Match header string false "ETag of a previous response"
 // @Success 200 {array} models.Item
+// @Header 200 {string} ETag "Weak entity tag of the listed items"
+// @Success 304 {string} string "Not Modified"
+// @Failure 400 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /inventory [get]
 func GetItems(c *gin.Context) {
 	var items []models.Item
 
 	db := utils.ConnectDatabase()
-	query := db.Model(&models.Item{})
-
-	// Filters
-	if name := c.Query("name"); name != "" {
-		// Case-insensitive match (PostgreSQL)
-		query = query.Where("name ILIKE ?", "%"+name+"%")
-	}
-	if minStockStr := c.Query("min_stock"); minStockStr != "" {
-		if minStock, err := strconv.Atoi(minStockStr); err == nil {
-			query = query.Where("stock >= ?", minStock)
-		}
-	}
-
-	// Sorting (whitelist fields) to prevent SQL injection
-	allowedFields := map[string]bool{
-		"name":       true,
-		"stock":      true,
-		"price":      true,
-		"created_at": true,
-	}
-	sortBy := c.DefaultQuery("sort_by", "created_at")
-	if !allowedFields[sortBy] {
-		sortBy = "created_at"
-	}
-	order := c.DefaultQuery("order", "desc")
-	if order != "asc" && order != "desc" {
-		order = "desc"
+	query, err := itemQuery(c, db)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
 	}
-	orderClause := fmt.Sprintf("%s %s", sortBy, order)
-	query = query.Order(orderClause)
 
-	// Pagination (with sane bounds)
-	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
-	if err != nil || limit < 1 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
-	if err != nil || offset < 0 {
-		offset = 0
-	}
+	limit, offset := paginate(c)
 
 	if err := query.Limit(limit).Offset(offset).Find(&items).Error; err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
+	if notModified(c, listETag(items)) {
+		return
+	}
+
 	c.JSON(http.StatusOK, items)
 }
 
 // GetItemByID handles GET /inventory/:id requests and returns the matching item.
 // @Summary Get an inventory item
-// @Description Retrieve a single inventory item by its identifier.
+// @Description Retrieve a single inventory item by its identifier, with its stock at each location. With as_of, the item's name, stock and price at that time are returned instead.
 // @Tags inventory
 // @Accept json
 // @Produce json
 // @Param id path string true "Item ID"
+// @Param as_of query string false "Return the item as it was at this RFC 3339 time"
+// @Param If-None-Match header string false "ETag of a previous response"
 // @Success 200 {object} models.Item
+// @Header 200 {string} ETag "Item version"
+// @Success 304 {string} string "Not Modified"
+// @Failure 400 {object} map[string]string
 // @Failure 404 {object} map[string]string
 // @Router /inventory/{id} [get]
 func GetItemByID(c *gin.Context) {
-	id := c.Param("id")
-	var item models.Item
-	db := utils.ConnectDatabase()
-	if err := db.First(&item, "id = ?", id).Error; err != nil {
+	asOf, historical, err := asOfParam(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	var at *time.Time
+	if historical {
+		at = &asOf
+	}
+
+	item, err := services.FindItem(utils.ConnectDatabase(), c.Param("id"), at)
+	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "item not found"})
 		return
 	}
+	if notModified(c, itemETag(*item)) {
+		return
+	}
 	c.JSON(http.StatusOK, item)
 }
 
 // CreateItem handles POST /inventory requests to add a new inventory item.
 // @Summary Create a new inventory item
-// @Description Create a new inventory item by providing its core attributes.
+// @Description Create a new inventory item by providing its core attributes. The SKU and barcode must not be used by any other item, including those in the trash.
 // @Tags inventory
 // @Accept json
 // @Produce json
 // @Param item body CreateItemRequest true "Item to create"
 // @Success 201 {object} models.Item
 // @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /inventory [post]
 func CreateItem(c *gin.Context) {
@@ -135,93 +157,213 @@ func CreateItem(c *gin.Context) {
 		return
 	}
 
-	db := utils.ConnectDatabase()
 	item := models.Item{
-		Name:  input.Name,
-		Stock: input.Stock,
-		Price: input.Price,
+		SKU:               input.SKU,
+		Barcode:           input.Barcode,
+		Name:              input.Name,
+		CategoryID:        input.CategoryID,
+		Tags:              input.Tags,
+		Attributes:        input.Attributes,
+		AttributeSchemaID: input.SchemaID,
+		Price:             *input.Price,
+		Currency:          input.Currency,
+		ReorderPoint:      input.ReorderPoint,
+		ReorderQuantity:   input.ReorderQuantity,
+		SafetyStock:       input.SafetyStock,
+	}
+	if item.Currency == "" {
+		item.Currency = models.DefaultCurrency
+	}
+	if err := services.ValidateItem(&item); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
 	}
 
-	if err := db.Create(&item).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return services.CreateItem(tx, &item, input.Stock, auditContext(c))
+	})
+	if err != nil {
+		respondError(c, err)
 		return
 	}
 
+	c.Header("ETag", itemETag(item))
 	c.JSON(http.StatusCreated, item)
 }
 
 // UpdateItem handles PUT /inventory/:id requests to modify an existing inventory item.
 // @Summary Update an inventory item
-// @Description Update the mutable fields of an existing inventory item.
+// @Description Update the mutable fields of an existing inventory item. A stock value is recorded as an adjustment movement; an empty barcode removes it.
 // @Tags inventory
 // @Accept json
 // @Produce json
 // @Param id path string true "Item ID"
+// @Param If-Match header string false "ETag the update is conditional on"
 // @Param item body UpdateItemRequest true "Fields to update"
 // @Success 200 {object} models.Item
+// @Header 200 {string} ETag "New item version"
 // @Failure 400 {object} map[string]string
 // @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 412 {object} map[string]string
+// @Failure 428 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /inventory/{id} [put]
 func UpdateItem(c *gin.Context) {
-	id := c.Param("id")
-	var item models.Item
-
-	db := utils.ConnectDatabase()
-	if err := db.First(&item, "id = ?", id).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "item not found"})
-		return
-	}
-
 	var payload UpdateItemRequest
 	if err := c.ShouldBindJSON(&payload); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-
-	if payload.Name != nil {
-		item.Name = *payload.Name
-	}
-	if payload.Stock != nil {
-		item.Stock = *payload.Stock
-	}
-	if payload.Price != nil {
-		item.Price = *payload.Price
+	patch := services.ItemPatch{
+		SKU:             payload.SKU,
+		Barcode:         payload.Barcode,
+		Name:            payload.Name,
+		CategoryID:      payload.CategoryID,
+		Tags:            payload.Tags,
+		Attributes:      payload.Attributes,
+		SchemaID:        payload.SchemaID,
+		Stock:           payload.Stock,
+		Price:           payload.Price,
+		Currency:        payload.Currency,
+		ReorderPoint:    payload.ReorderPoint,
+		ReorderQuantity: payload.ReorderQuantity,
+		SafetyStock:     payload.SafetyStock,
 	}
 
-	if err := db.Save(&item).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	var item *models.Item
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		item, err = services.UpdateItem(tx, c.Param("id"), patch, auditContext(c), func(current models.Item) error {
+			return checkIfMatch(c, current)
+		})
+		return err
+	})
+	if err != nil {
+		respondError(c, err)
 		return
 	}
 
+	c.Header("ETag", itemETag(*item))
 	c.JSON(http.StatusOK, item)
 }
 
 // DeleteItem handles DELETE /inventory/:id requests to remove an item from inventory.
 // @Summary Delete an inventory item
-// @Description Remove an inventory item by its identifier.
+// @Description Move an inventory item to the trash. It can be restored until the retention job purges it. Items with reserved or in-transit stock cannot be deleted until their reservations, sales order allocations and transfers are closed.
 // @Tags inventory
 // @Accept json
 // @Produce json
 // @Param id path string true "Item ID"
+// @Param If-Match header string false "ETag the delete is conditional on"
 // @Success 204 {string} string "No Content"
 // @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 412 {object} map[string]string
+// @Failure 428 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /inventory/{id} [delete]
 func DeleteItem(c *gin.Context) {
-	id := c.Param("id")
 	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return services.DeleteItem(tx, c.Param("id"), auditContext(c), func(current models.Item) error {
+			return checkIfMatch(c, current)
+		})
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
 
+	c.Status(http.StatusNoContent)
+}
+
+// GetItemBySKU handles GET /inventory/by-sku/:sku requests and returns the matching item.
+// @Summary Look up an item by SKU
+// @Description Retrieve a single inventory item by its stock keeping unit.
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param sku path string true "Item SKU"
+// @Success 200 {object} models.Item
+// @Header 200 {string} ETag "Item version"
+// @Failure 404 {object} map[string]string
+// @Router /inventory/by-sku/{sku} [get]
+func GetItemBySKU(c *gin.Context) {
 	var item models.Item
-	if err := db.First(&item, "id = ?", id).Error; err != nil {
+	db := utils.ConnectDatabase()
+	if err := db.Preload("Locations.Location").First(&item, "sku = ?", c.Param("sku")).Error; err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "item not found"})
 		return
 	}
+	c.Header("ETag", itemETag(item))
+	c.JSON(http.StatusOK, item)
+}
 
-	if err := db.Delete(&item).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+// GetItemByBarcode handles GET /inventory/by-barcode/:code requests and returns the matching item.
+// @Summary Look up an item by barcode
+// @Description Retrieve a single inventory item by its GTIN-8, UPC-A, EAN-13 or GTIN-14 barcode. Equivalent forms match, so a UPC-A finds an item stored under its EAN-13.
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param code path string true "Barcode digits"
+// @Success 200 {object} models.Item
+// @Header 200 {string} ETag "Item version"
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /inventory/by-barcode/{code} [get]
+func GetItemByBarcode(c *gin.Context) {
+	code := c.Param("code")
+	if err := services.ValidateBarcode(code); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.Status(http.StatusNoContent)
+	var item models.Item
+	db := utils.ConnectDatabase()
+	err := db.Preload("Locations.Location").
+		First(&item, "lpad(barcode, 14, '0') = ?", services.GTIN14(code)).Error
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "item not found"})
+		return
+	}
+	c.Header("ETag", itemETag(item))
+	c.JSON(http.StatusOK, item)
+}
+
+// itemQuery builds the filtered and sorted item query shared by GetItems and the
+// batch label endpoint from the request's query params. Its errors are client errors.
+func itemQuery(c *gin.Context, db *gorm.DB) (*gorm.DB, error) {
+	filter := services.ItemFilter{
+		Name:               c.Query("name"),
+		Currency:           c.Query("currency"),
+		CategoryID:         c.Query("category"),
+		IncludeDescendants: c.Query("include_descendants") == "true",
+		Tags:               c.QueryArray("tag"),
+		Attributes:         map[string]string{},
+		Location:           c.Query("location"),
+		SortBy:             c.Query("sort_by"),
+		Order:              c.Query("order"),
+	}
+
+	asOf, historical, err := asOfParam(c)
+	if err != nil {
+		return nil, err
+	}
+	if historical {
+		filter.AsOf = &asOf
+	}
+	// attr.<name>=value and attr.<name>_gt and friends filter on attributes
+	for key, values := range c.Request.URL.Query() {
+		if name, ok := strings.CutPrefix(key, "attr."); ok {
+			filter.Attributes[name] = values[0]
+		}
+	}
+	// Unparsable minimums are ignored
+	if minStock, err := strconv.Atoi(c.Query("min_stock")); err == nil {
+		filter.MinStock = &minStock
+	}
+	return services.ItemQuery(db, filter)
 }
@@ -0,0 +1,179 @@
+package controllers
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"inventory-service/src/labels"
+	"inventory-service/src/models"
+	"inventory-service/src/utils"
+)
+
+// Most labels a single batch request may list by ID
+const maxLabelIDs = 100
+
+// GetItemLabel handles GET /inventory/:id/label requests and renders a shelf label for the item.
+// @Summary Render an item label
+// @Description Render a Code128 or QR label carrying the item's SKU (or ID or barcode) with its name and price, as PNG, SVG or a printable A4 PDF sheet. On PDF labels, Code128 too long to fit (such as a backfilled ITEM-<uuid> SKU) is printed as QR.
+// @Tags labels
+// @Produce image/png,image/svg+xml,application/pdf
+// @Param id path string true "Item ID"
+// @Param symbology query string false "Symbol type (code128|qr), default code128"
+// @Param format query string false "Output format (png|svg|pdf), default png"
+// @Param encode query string false "Value encoded in the symbol (sku|id|barcode), default sku"
+// @Success 200 {file} file
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/{id}/label [get]
+func GetItemLabel(c *gin.Context) {
+	var item models.Item
+	db := utils.ConnectDatabase()
+	if err := db.First(&item, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "item not found"})
+		return
+	}
+
+	renderLabels(c, []models.Item{item}, "label-"+item.SKU)
+}
+
+// GetLabels handles GET /inventory/labels requests and renders a page of labels.
+// @Summary Render a batch of labels
+// @Description Render labels for the listed item IDs or, without ids, for the items matched by the same filters, sorting and pagination as GET /inventory. PNG and SVG lay labels out three across; PDF fills A4 sheets of 24 and prints Code128 too long to fit a label as QR.
+// @Tags labels
+// @Produce image/png,image/svg+xml,application/pdf
+// @Param ids query string false "Comma-separated item IDs (at most 100), printed in the given order"
+// @Param name query string false "Filter by item name (case-insensitive)"
+// @Param currency query string false "Only items priced in this ISO 4217 currency"
+// @Param category query string false "Only items in this category (ID)"
+// @Param include_descendants query bool false "With category, also include items in its subcategories"
+// @Param tag query []string false "Only items with this tag (repeatable; all must match)" collectionFormat(multi)
+// @Param attr.name query string false "Only items whose attribute equals the value, e.g. attr.color=red; attr.name_gt, _gte, _lt and _lte compare numbers, e.g. attr.weight_gt=2"
+// @Param as_of query string false "Label items as they were at this RFC 3339 time"
+// @Param location query string false "Only items stocked at this location (ID or code); not combinable with as_of"
+// @Param min_stock query int false "Minimum stock filter (per location when location is given)"
+// @Param limit query int false "Items per page (default 10, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Param sort_by query string false "Sort field (name|stock|price|created_at)"
+// @Param order query string false "Sort order (asc|desc)"
+// @Param symbology query string false "Symbol type (code128|qr), default code128"
+// @Param format query string false "Output format (png|svg|pdf), default png"
+// @Param encode query string false "Value encoded in each symbol (sku|id|barcode), default sku"
+// @Success 200 {file} file
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/labels [get]
+func GetLabels(c *gin.Context) {
+	var items []models.Item
+	db := utils.ConnectDatabase()
+
+	if idsParam := c.Query("ids"); idsParam != "" {
+		ids := strings.Split(idsParam, ",")
+		if len(ids) > maxLabelIDs {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("at most %d ids may be given", maxLabelIDs)})
+			return
+		}
+		for i, id := range ids {
+			ids[i] = strings.TrimSpace(id)
+			if uuid.Validate(ids[i]) != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid item id %q", ids[i])})
+				return
+			}
+		}
+		if err := db.Where("id IN ?", ids).Find(&items).Error; err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		// Print in the requested order and report any IDs that did not match
+		byID := make(map[string]models.Item, len(items))
+		for _, item := range items {
+			byID[item.ID] = item
+		}
+		items = items[:0]
+		for _, id := range ids {
+			item, ok := byID[id]
+			if !ok {
+				c.JSON(http.StatusNotFound, gin.H{"error": "item not found: " + id})
+				return
+			}
+			items = append(items, item)
+		}
+	} else {
+		query, err := itemQuery(c, db)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		limit, offset := paginate(c)
+		if err := query.Limit(limit).Offset(offset).Find(&items).Error; err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if len(items) == 0 {
+			c.JSON(http.StatusNotFound, gin.H{"error": "no items matched"})
+			return
+		}
+	}
+
+	renderLabels(c, items, "labels")
+}
+
+// renderLabels writes the items' labels in the symbology, format and encoding
+// requested by the query params.
+func renderLabels(c *gin.Context, items []models.Item, filename string) {
+	symbology := c.DefaultQuery("symbology", labels.Code128)
+	format := c.DefaultQuery("format", labels.PNG)
+	encode := c.DefaultQuery("encode", "sku")
+
+	batch := make([]labels.Label, 0, len(items))
+	for _, item := range items {
+		label, err := itemLabel(item, encode)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		batch = append(batch, label)
+	}
+
+	var buf bytes.Buffer
+	if err := labels.Render(&buf, batch, symbology, format); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`inline; filename="%s.%s"`, filename, format))
+	c.Data(http.StatusOK, labels.ContentType(format), buf.Bytes())
+}
+
+// itemLabel lays out the label of one item, encoding its SKU, ID or barcode.
+func itemLabel(item models.Item, encode string) (labels.Label, error) {
+	var content string
+	switch encode {
+	case "sku":
+		content = item.SKU
+	case "id":
+		content = item.ID
+	case "barcode":
+		if item.Barcode == nil {
+			return labels.Label{}, fmt.Errorf("item %s has no barcode", item.SKU)
+		}
+		content = *item.Barcode
+	default:
+		return labels.Label{}, fmt.Errorf("encode must be sku, id or barcode")
+	}
+
+	price := item.Price.StringFixed(models.CurrencyMinorUnits(item.Currency)) + " " + item.Currency
+	lines := []string{item.Name, "SKU " + item.SKU, price}
+	// Print the encoded value when it is not already one of the lines
+	if encode != "sku" {
+		lines = append([]string{content}, lines...)
+	}
+	return labels.Label{Content: content, Lines: lines}, nil
+}
@@ -0,0 +1,271 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+	"inventory-service/src/services"
+	"inventory-service/src/utils"
+)
+
+// CreateLocationRequest defines the payload required to create a new location.
+type CreateLocationRequest struct {
+	Code      string `json:"code" binding:"required,max=32" example:"WH-EAST"`
+	Name      string `json:"name" binding:"required" example:"East warehouse"`
+	Address   string `json:"address" example:"12 Harbour Rd, Dammam"`
+	IsDefault bool   `json:"is_default" example:"false"`
+}
+
+// UpdateLocationRequest defines the fields that can be updated on a location.
+type UpdateLocationRequest struct {
+	Code      *string `json:"code" binding:"omitempty,max=32" example:"WH-EAST"`
+	Name      *string `json:"name" example:"East warehouse"`
+	Address   *string `json:"address" example:"14 Harbour Rd, Dammam"`
+	IsDefault *bool   `json:"is_default" example:"true"`
+}
+
+// GetLocations handles GET /locations requests and returns all locations.
+// @Summary List locations
+// @Description Retrieve the warehouses and other places stock can be held, ordered by code.
+// @Tags locations
+// @Accept json
+// @Produce json
+// @Param limit query int false "Locations per page (default 10, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {array} models.Location
+// @Failure 500 {object} map[string]string
+// @Router /locations [get]
+func GetLocations(c *gin.Context) {
+	limit, offset := paginate(c)
+
+	var locations []models.Location
+	db := utils.ConnectDatabase()
+	if err := db.Order("code asc").Limit(limit).Offset(offset).Find(&locations).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, locations)
+}
+
+// GetLocationByID handles GET /locations/:id requests and returns the matching location.
+// @Summary Get a location
+// @Description Retrieve a single location by its identifier.
+// @Tags locations
+// @Accept json
+// @Produce json
+// @Param id path string true "Location ID"
+// @Success 200 {object} models.Location
+// @Failure 404 {object} map[string]string
+// @Router /locations/{id} [get]
+func GetLocationByID(c *gin.Context) {
+	var location models.Location
+	db := utils.ConnectDatabase()
+	if err := db.First(&location, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "location not found"})
+		return
+	}
+	c.JSON(http.StatusOK, location)
+}
+
+// CreateLocation handles POST /locations requests to add a new location.
+// @Summary Create a location
+// @Description Create a new warehouse or other stock location.
+// @Tags locations
+// @Accept json
+// @Produce json
+// @Param location body CreateLocationRequest true "Location to create"
+// @Success 201 {object} models.Location
+// @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /locations [post]
+func CreateLocation(c *gin.Context) {
+	var input CreateLocationRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	location := models.Location{
+		Code:      input.Code,
+		Name:      input.Name,
+		Address:   input.Address,
+		IsDefault: input.IsDefault,
+	}
+
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if location.IsDefault {
+			if err := clearDefaultLocation(tx); err != nil {
+				return err
+			}
+		}
+		return tx.Create(&location).Error
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, location)
+}
+
+// UpdateLocation handles PUT /locations/:id requests to modify an existing location.
+// @Summary Update a location
+// @Description Update the mutable fields of a location. Making a location the default clears the flag elsewhere.
+// @Tags locations
+// @Accept json
+// @Produce json
+// @Param id path string true "Location ID"
+// @Param location body UpdateLocationRequest true "Fields to update"
+// @Success 200 {object} models.Location
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /locations/{id} [put]
+func UpdateLocation(c *gin.Context) {
+	var location models.Location
+	db := utils.ConnectDatabase()
+	if err := db.First(&location, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "location not found"})
+		return
+	}
+
+	var payload UpdateLocationRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if payload.Code != nil {
+		location.Code = *payload.Code
+	}
+	if payload.Name != nil {
+		location.Name = *payload.Name
+	}
+	if payload.Address != nil {
+		location.Address = *payload.Address
+	}
+
+	// The default location can only be moved, never removed
+	if payload.IsDefault != nil && !*payload.IsDefault && location.IsDefault {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "make another location the default instead"})
+		return
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if payload.IsDefault != nil && *payload.IsDefault && !location.IsDefault {
+			if err := clearDefaultLocation(tx); err != nil {
+				return err
+			}
+			location.IsDefault = true
+		}
+		return tx.Save(&location).Error
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, location)
+}
+
+// DeleteLocation handles DELETE /locations/:id requests to remove an empty location.
+// @Summary Delete a location
+// @Description Remove a location that no longer holds any stock. The default location cannot be deleted.
+// @Tags locations
+// @Accept json
+// @Produce json
+// @Param id path string true "Location ID"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /locations/{id} [delete]
+func DeleteLocation(c *gin.Context) {
+	var location models.Location
+	db := utils.ConnectDatabase()
+	if err := db.First(&location, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "location not found"})
+		return
+	}
+
+	if location.IsDefault {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "the default location cannot be deleted"})
+		return
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var held int64
+		if err := tx.Model(&models.ItemStock{}).Where("location_id = ? AND quantity <> 0", location.ID).Count(&held).Error; err != nil {
+			return err
+		}
+		if held > 0 {
+			return services.ErrLocationInUse
+		}
+		if err := tx.Where("location_id = ?", location.ID).Delete(&models.ItemStock{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&location).Error
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetLocationStock handles GET /locations/:id/stock requests and returns the items held there.
+// @Summary List stock at a location
+// @Description Retrieve the quantity of every item held at a location.
+// @Tags locations
+// @Accept json
+// @Produce json
+// @Param id path string true "Location ID"
+// @Param min_stock query int false "Minimum quantity at this location"
+// @Param limit query int false "Rows per page (default 10, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {array} models.ItemStock
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /locations/{id}/stock [get]
+func GetLocationStock(c *gin.Context) {
+	var location models.Location
+	db := utils.ConnectDatabase()
+	if err := db.First(&location, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "location not found"})
+		return
+	}
+
+	// Deleted items keep their stock rows but are not listed
+	query := db.Preload("Item").
+		Joins("JOIN items ON items.id = item_stocks.item_id AND items.deleted_at IS NULL").
+		Where("item_stocks.location_id = ?", location.ID)
+	if minStockStr := c.Query("min_stock"); minStockStr != "" {
+		if minStock, err := strconv.Atoi(minStockStr); err == nil {
+			query = query.Where("item_stocks.quantity >= ?", minStock)
+		}
+	}
+
+	limit, offset := paginate(c)
+
+	var stocks []models.ItemStock
+	if err := query.Order("item_stocks.quantity desc").Limit(limit).Offset(offset).Find(&stocks).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, stocks)
+}
+
+func clearDefaultLocation(tx *gorm.DB) error {
+	return tx.Model(&models.Location{}).Where("is_default = ?", true).Update("is_default", false).Error
+}
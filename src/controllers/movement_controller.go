@@ -0,0 +1,109 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+	"inventory-service/src/services"
+	"inventory-service/src/utils"
+)
+
+// CreateMovementRequest defines the payload required to record a stock movement.
+type CreateMovementRequest struct {
+	Delta      int    `json:"delta" binding:"required" example:"-2"`
+	Reason     string `json:"reason" binding:"required" example:"sale"`
+	LocationID string `json:"location_id" example:"3f1c9a4e-8d2b-4c7e-9a61-2b5d0e7f4a10"`
+	Reference  string `json:"reference" example:"order-1042"`
+}
+
+// GetMovements handles GET /inventory/:id/movements requests and returns the item's ledger.
+// @Summary List stock movements
+// @Description Retrieve the stock movement ledger of an item, newest first.
+// @Tags movements
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Param limit query int false "Movements per page (default 10, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {array} models.StockMovement
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/{id}/movements [get]
+func GetMovements(c *gin.Context) {
+	id := c.Param("id")
+	db := utils.ConnectDatabase()
+
+	var item models.Item
+	if err := db.First(&item, "id = ?", id).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "item not found"})
+		return
+	}
+
+	limit, offset := paginate(c)
+
+	var movements []models.StockMovement
+	err := db.Where("item_id = ?", id).
+		Order("created_at desc").
+		Limit(limit).Offset(offset).
+		Find(&movements).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, movements)
+}
+
+// CreateMovement handles POST /inventory/:id/movements requests to change an item's stock.
+// @Summary Record a stock movement
+// @Description Change an item's stock by appending a movement (receipt, sale, adjustment or return) to its ledger. Receipts and returns take a positive delta, sales a negative one; adjustments go either way.
+// @Description Without a location, receipts land at the default location and outbound stock is drawn from wherever it is held.
+// @Tags movements
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Param movement body CreateMovementRequest true "Movement to record"
+// @Success 201 {object} models.StockMovement
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/{id}/movements [post]
+func CreateMovement(c *gin.Context) {
+	var input CreateMovementRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !models.ManualMovementReason(input.Reason) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "reason must be one of receipt, sale, adjustment, return"})
+		return
+	}
+
+	movement := models.StockMovement{
+		ItemID:    c.Param("id"),
+		Delta:     input.Delta,
+		Reason:    input.Reason,
+		Actor:     currentActor(c),
+		Reference: input.Reference,
+	}
+	if input.LocationID != "" {
+		movement.LocationID = &input.LocationID
+	}
+
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		_, err := services.RecordMovement(tx, &movement)
+		return err
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, movement)
+}
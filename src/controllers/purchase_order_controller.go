@@ -0,0 +1,293 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+	"inventory-service/src/services"
+	"inventory-service/src/utils"
+)
+
+// PurchaseOrderLineRequest defines one item ordered on a purchase order.
+type PurchaseOrderLineRequest struct {
+	ItemID   string           `json:"item_id" binding:"required" example:"5d3c0a2e-7b1f-4e8a-9c6d-1f2e3a4b5c6d"`
+	Quantity int              `json:"quantity" binding:"required,gt=0" example:"50"`
+	UnitCost *decimal.Decimal `json:"unit_cost" swaggertype:"string" example:"812.50"`
+}
+
+// PurchaseOrderRequest defines the payload to create or replace a draft purchase order.
+type PurchaseOrderRequest struct {
+	SupplierID string                     `json:"supplier_id" binding:"required" example:"7a6b5c4d-3e2f-4a1b-9c8d-7e6f5a4b3c2d"`
+	LocationID string                     `json:"location_id" example:"3f1c9a4e-8d2b-4c7e-9a61-2b5d0e7f4a10"`
+	Reference  string                     `json:"reference" binding:"max=255" example:"PO-2024-0042"`
+	Notes      string                     `json:"notes" example:"Deliver to dock 2"`
+	Lines      []PurchaseOrderLineRequest `json:"lines" binding:"required,min=1,dive"`
+}
+
+// ReceiptLineRequest defines the units received against one purchase order line.
+type ReceiptLineRequest struct {
+	LineID   string `json:"line_id" binding:"required" example:"0c9b8a7d-6e5f-4a3b-8c2d-1e0f9a8b7c6d"`
+	Quantity int    `json:"quantity" binding:"required,gt=0" example:"20"`
+}
+
+// ReceivePurchaseOrderRequest defines the payload for receiving goods on a purchase order.
+type ReceivePurchaseOrderRequest struct {
+	Lines            []ReceiptLineRequest `json:"lines" binding:"required,min=1,dive"`
+	LocationID       string               `json:"location_id" example:"3f1c9a4e-8d2b-4c7e-9a61-2b5d0e7f4a10"`
+	AllowOverReceipt bool                 `json:"allow_over_receipt" example:"false"`
+}
+
+// GetPurchaseOrders handles GET /purchase-orders requests and returns purchase orders.
+// @Summary List purchase orders
+// @Description Retrieve purchase orders with their lines, newest first.
+// @Tags purchase-orders
+// @Accept json
+// @Produce json
+// @Param status query string false "Filter by status (draft|sent|partially_received|received|cancelled)"
+// @Param supplier_id query string false "Filter by supplier"
+// @Param item_id query string false "Only orders with a line for this item"
+// @Param limit query int false "Orders per page (default 10, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {array} models.PurchaseOrder
+// @Failure 500 {object} map[string]string
+// @Router /purchase-orders [get]
+func GetPurchaseOrders(c *gin.Context) {
+	limit, offset := paginate(c)
+
+	db := utils.ConnectDatabase()
+	query := preloadPurchaseOrder(db)
+	if status := c.Query("status"); status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if supplierID := c.Query("supplier_id"); supplierID != "" {
+		query = query.Where("supplier_id = ?", supplierID)
+	}
+	if itemID := c.Query("item_id"); itemID != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM purchase_order_lines WHERE purchase_order_lines.purchase_order_id = purchase_orders.id AND purchase_order_lines.item_id = ?)", itemID)
+	}
+
+	var orders []models.PurchaseOrder
+	if err := query.Order("created_at desc").Limit(limit).Offset(offset).Find(&orders).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, orders)
+}
+
+// GetPurchaseOrderByID handles GET /purchase-orders/:id requests and returns the matching order.
+// @Summary Get a purchase order
+// @Description Retrieve a single purchase order with its supplier and lines.
+// @Tags purchase-orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Purchase order ID"
+// @Success 200 {object} models.PurchaseOrder
+// @Failure 404 {object} map[string]string
+// @Router /purchase-orders/{id} [get]
+func GetPurchaseOrderByID(c *gin.Context) {
+	var order models.PurchaseOrder
+	db := utils.ConnectDatabase()
+	if err := preloadPurchaseOrder(db).First(&order, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "purchase order not found"})
+		return
+	}
+	c.JSON(http.StatusOK, order)
+}
+
+// CreatePurchaseOrder handles POST /purchase-orders requests to draft a new order.
+// @Summary Create a purchase order
+// @Description Draft a purchase order with a supplier. Lines without a unit cost use the supplier's cost for the item; costs are in the supplier's currency.
+// @Tags purchase-orders
+// @Accept json
+// @Produce json
+// @Param order body PurchaseOrderRequest true "Order to create"
+// @Success 201 {object} models.PurchaseOrder
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /purchase-orders [post]
+func CreatePurchaseOrder(c *gin.Context) {
+	var input PurchaseOrderRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	order := models.PurchaseOrder{CreatedBy: currentActor(c)}
+	savePurchaseOrder(c, &order, input, http.StatusCreated)
+}
+
+// UpdatePurchaseOrder handles PUT /purchase-orders/:id requests to replace a draft order.
+// @Summary Replace a draft purchase order
+// @Description Replace the supplier, location, reference, notes and lines of an order that has not been sent yet.
+// @Tags purchase-orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Purchase order ID"
+// @Param order body PurchaseOrderRequest true "New order"
+// @Success 200 {object} models.PurchaseOrder
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /purchase-orders/{id} [put]
+func UpdatePurchaseOrder(c *gin.Context) {
+	var input PurchaseOrderRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	order := models.PurchaseOrder{ID: c.Param("id")}
+	savePurchaseOrder(c, &order, input, http.StatusOK)
+}
+
+// DeletePurchaseOrder handles DELETE /purchase-orders/:id requests to discard a draft order.
+// @Summary Delete a draft purchase order
+// @Description Remove an order that has not been sent yet. Sent orders are cancelled instead.
+// @Tags purchase-orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Purchase order ID"
+// @Success 204 {string} string "No Content"
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /purchase-orders/{id} [delete]
+func DeletePurchaseOrder(c *gin.Context) {
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return services.DeletePurchaseOrder(tx, c.Param("id"))
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SendPurchaseOrder handles POST /purchase-orders/:id/send requests.
+// @Summary Send a purchase order
+// @Description Mark a draft order as sent to the supplier. Its lines can no longer be edited and goods can be received against it.
+// @Tags purchase-orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Purchase order ID"
+// @Success 200 {object} models.PurchaseOrder
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /purchase-orders/{id}/send [post]
+func SendPurchaseOrder(c *gin.Context) {
+	changePurchaseOrder(c, func(tx *gorm.DB, id string) (*models.PurchaseOrder, error) {
+		return services.SendPurchaseOrder(tx, id)
+	})
+}
+
+// CancelPurchaseOrder handles POST /purchase-orders/:id/cancel requests.
+// @Summary Cancel a purchase order
+// @Description Close an order that is not fully received. Units already received stay in stock.
+// @Tags purchase-orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Purchase order ID"
+// @Success 200 {object} models.PurchaseOrder
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /purchase-orders/{id}/cancel [post]
+func CancelPurchaseOrder(c *gin.Context) {
+	changePurchaseOrder(c, func(tx *gorm.DB, id string) (*models.PurchaseOrder, error) {
+		return services.CancelPurchaseOrder(tx, id)
+	})
+}
+
+// ReceivePurchaseOrder handles POST /purchase-orders/:id/receive requests.
+// @Summary Receive goods on a purchase order
+// @Description Book received units into stock as receipt movements, all in one transaction, at location_id or else the order's location.
+// @Description Receiving more than a line's outstanding quantity is rejected unless allow_over_receipt is set.
+// @Tags purchase-orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Purchase order ID"
+// @Param receipt body ReceivePurchaseOrderRequest true "Units received per line"
+// @Success 200 {object} models.PurchaseOrder
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /purchase-orders/{id}/receive [post]
+func ReceivePurchaseOrder(c *gin.Context) {
+	var input ReceivePurchaseOrderRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	receipts := make([]services.ReceiptLine, len(input.Lines))
+	for i, line := range input.Lines {
+		receipts[i] = services.ReceiptLine{LineID: line.LineID, Quantity: line.Quantity}
+	}
+	var locationID *string
+	if input.LocationID != "" {
+		locationID = &input.LocationID
+	}
+
+	changePurchaseOrder(c, func(tx *gorm.DB, id string) (*models.PurchaseOrder, error) {
+		return services.ReceivePurchaseOrder(tx, id, receipts, locationID, input.AllowOverReceipt, currentActor(c))
+	})
+}
+
+func savePurchaseOrder(c *gin.Context, order *models.PurchaseOrder, input PurchaseOrderRequest, status int) {
+	order.SupplierID = input.SupplierID
+	order.Reference = input.Reference
+	order.Notes = input.Notes
+	if input.LocationID != "" {
+		order.LocationID = &input.LocationID
+	}
+
+	lines := make([]services.PurchaseLineInput, len(input.Lines))
+	for i, line := range input.Lines {
+		lines[i] = services.PurchaseLineInput{ItemID: line.ItemID, Quantity: line.Quantity, UnitCost: line.UnitCost}
+	}
+
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return services.SavePurchaseOrder(tx, order, lines)
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(status, order)
+}
+
+func changePurchaseOrder(c *gin.Context, change func(tx *gorm.DB, id string) (*models.PurchaseOrder, error)) {
+	db := utils.ConnectDatabase()
+
+	var order *models.PurchaseOrder
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		order, err = change(tx, c.Param("id"))
+		return err
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, order)
+}
+
+func preloadPurchaseOrder(db *gorm.DB) *gorm.DB {
+	return db.Preload("Supplier").Preload("Lines", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position asc")
+	})
+}
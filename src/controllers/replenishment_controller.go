@@ -0,0 +1,42 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"inventory-service/src/services"
+	"inventory-service/src/utils"
+)
+
+// GetReplenishment handles GET /inventory/replenishment requests and lists items to reorder.
+// @Summary Replenishment report
+// @Description List items at or below their reorder point with a suggested order quantity and the supplier to order from.
+// @Description The reorder point is compared with the inventory position: available and in-transit units plus units on open purchase orders (drafts included), less units backordered on sales orders.
+// @Tags replenishment
+// @Accept json
+// @Produce json
+// @Param supplier_id query string false "Only items to order from this supplier"
+// @Success 200 {array} services.ReplenishmentSuggestion
+// @Failure 500 {object} map[string]string
+// @Router /inventory/replenishment [get]
+func GetReplenishment(c *gin.Context) {
+	db := utils.ConnectDatabase()
+	suggestions, err := services.ReplenishmentReport(db)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if supplierID := c.Query("supplier_id"); supplierID != "" {
+		filtered := suggestions[:0]
+		for _, suggestion := range suggestions {
+			if suggestion.Supplier != nil && suggestion.Supplier.SupplierID == supplierID {
+				filtered = append(filtered, suggestion)
+			}
+		}
+		suggestions = filtered
+	}
+
+	c.JSON(http.StatusOK, suggestions)
+}
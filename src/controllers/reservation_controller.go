@@ -0,0 +1,168 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+	"inventory-service/src/services"
+	"inventory-service/src/utils"
+)
+
+const (
+	defaultReservationTTL = 15 * time.Minute
+	maxReservationTTL     = 24 * time.Hour
+)
+
+// CreateReservationRequest defines the payload required to hold stock of an item.
+type CreateReservationRequest struct {
+	Quantity   int    `json:"quantity" binding:"required,gt=0" example:"2"`
+	TTLSeconds int    `json:"ttl_seconds" binding:"omitempty,gt=0" example:"900"`
+	Reference  string `json:"reference" example:"checkout-8812"`
+}
+
+// GetReservations handles GET /inventory/:id/reservations requests and returns the item's holds.
+// @Summary List reservations
+// @Description Retrieve the stock reservations of an item, newest first.
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Param status query string false "Filter by status (active|committed|released|expired)"
+// @Param limit query int false "Reservations per page (default 10, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {array} models.Reservation
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/{id}/reservations [get]
+func GetReservations(c *gin.Context) {
+	id := c.Param("id")
+	db := utils.ConnectDatabase()
+
+	var item models.Item
+	if err := db.First(&item, "id = ?", id).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "item not found"})
+		return
+	}
+
+	query := db.Where("item_id = ?", id)
+	if status := c.Query("status"); status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	limit, offset := paginate(c)
+
+	var reservations []models.Reservation
+	if err := query.Order("created_at desc").Limit(limit).Offset(offset).Find(&reservations).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, reservations)
+}
+
+// CreateReservation handles POST /inventory/:id/reservations requests to hold stock.
+// @Summary Reserve stock
+// @Description Atomically hold available stock of an item for a limited time (default 15 minutes, max 24 hours).
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Param reservation body CreateReservationRequest true "Reservation to create"
+// @Success 201 {object} models.Reservation
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/{id}/reservations [post]
+func CreateReservation(c *gin.Context) {
+	var input CreateReservationRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ttl := defaultReservationTTL
+	if input.TTLSeconds > 0 {
+		ttl = time.Duration(input.TTLSeconds) * time.Second
+	}
+	if ttl > maxReservationTTL {
+		ttl = maxReservationTTL
+	}
+
+	var reservation *models.Reservation
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		reservation, err = services.Reserve(tx, c.Param("id"), input.Quantity, ttl, input.Reference)
+		return err
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, reservation)
+}
+
+// CommitReservation handles POST /inventory/:id/reservations/:reservation_id/commit requests.
+// @Summary Commit a reservation
+// @Description Convert an active reservation into a sale, removing the held units from stock.
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Param reservation_id path string true "Reservation ID"
+// @Success 200 {object} models.Reservation
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/{id}/reservations/{reservation_id}/commit [post]
+func CommitReservation(c *gin.Context) {
+	changeReservation(c, func(tx *gorm.DB, id string) (*models.Reservation, error) {
+		return services.CommitReservation(tx, id, currentActor(c))
+	})
+}
+
+// ReleaseReservation handles POST /inventory/:id/reservations/:reservation_id/release requests.
+// @Summary Release a reservation
+// @Description Return the units of an active reservation to available stock.
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Param reservation_id path string true "Reservation ID"
+// @Success 200 {object} models.Reservation
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/{id}/reservations/{reservation_id}/release [post]
+func ReleaseReservation(c *gin.Context) {
+	changeReservation(c, services.ReleaseReservation)
+}
+
+func changeReservation(c *gin.Context, change func(tx *gorm.DB, id string) (*models.Reservation, error)) {
+	db := utils.ConnectDatabase()
+
+	var existing models.Reservation
+	if err := db.First(&existing, "id = ? AND item_id = ?", c.Param("reservation_id"), c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "reservation not found"})
+		return
+	}
+
+	var reservation *models.Reservation
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		reservation, err = change(tx, existing.ID)
+		return err
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, reservation)
+}
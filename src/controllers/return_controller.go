@@ -0,0 +1,259 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+	"inventory-service/src/services"
+	"inventory-service/src/utils"
+)
+
+// ReturnLineRequest defines one item authorized for return.
+type ReturnLineRequest struct {
+	ItemID   string `json:"item_id" binding:"required" example:"5d3c0a2e-7b1f-4e8a-9c6d-1f2e3a4b5c6d"`
+	Quantity int    `json:"quantity" binding:"required,gt=0" example:"2"`
+}
+
+// CreateReturnRequest defines the payload required to authorize a return.
+type CreateReturnRequest struct {
+	SalesOrderID string              `json:"sales_order_id" example:"2b4d6f80-1a3c-4e5f-9b7d-0c2e4a6b8d1f"`
+	Customer     string              `json:"customer" binding:"required,max=255" example:"Nora Retail"`
+	Reason       string              `json:"reason" example:"Damaged in transit"`
+	Lines        []ReturnLineRequest `json:"lines" binding:"required,min=1,dive"`
+}
+
+// DispositionRequest defines what to do with a quantity of one return line.
+type DispositionRequest struct {
+	LineID      string `json:"line_id" binding:"required" example:"0c9b8a7d-6e5f-4a3b-8c2d-1e0f9a8b7c6d"`
+	Quantity    int    `json:"quantity" binding:"required,gt=0" example:"1"`
+	Disposition string `json:"disposition" binding:"required,oneof=restock quarantine scrap" example:"restock"`
+	Note        string `json:"note" example:"Box crushed, unit intact"`
+}
+
+// ReturnDispositionsRequest defines the payload for receiving returned units or
+// releasing them from quarantine.
+type ReturnDispositionsRequest struct {
+	Lines      []DispositionRequest `json:"lines" binding:"required,min=1,dive"`
+	LocationID string               `json:"location_id" example:"3f1c9a4e-8d2b-4c7e-9a61-2b5d0e7f4a10"`
+}
+
+// GetReturns handles GET /returns requests and returns return authorizations.
+// @Summary List returns
+// @Description Retrieve return authorizations (RMAs) with their lines, newest first.
+// @Tags returns
+// @Accept json
+// @Produce json
+// @Param status query string false "Filter by status (authorized|partially_received|received|cancelled)"
+// @Param sales_order_id query string false "Filter by sales order"
+// @Param item_id query string false "Only returns with a line for this item"
+// @Param limit query int false "Returns per page (default 10, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {array} models.ReturnAuthorization
+// @Failure 500 {object} map[string]string
+// @Router /returns [get]
+func GetReturns(c *gin.Context) {
+	limit, offset := paginate(c)
+
+	db := utils.ConnectDatabase()
+	query := db.Preload("Lines", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position asc")
+	})
+	if status := c.Query("status"); status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if salesOrderID := c.Query("sales_order_id"); salesOrderID != "" {
+		query = query.Where("sales_order_id = ?", salesOrderID)
+	}
+	if itemID := c.Query("item_id"); itemID != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM return_lines WHERE return_lines.return_authorization_id = return_authorizations.id AND return_lines.item_id = ?)", itemID)
+	}
+
+	var returns []models.ReturnAuthorization
+	if err := query.Order("created_at desc").Limit(limit).Offset(offset).Find(&returns).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, returns)
+}
+
+// GetReturnByID handles GET /returns/:id requests and returns the matching return.
+// @Summary Get a return
+// @Description Retrieve a single return authorization with its lines and every disposition recorded against it.
+// @Tags returns
+// @Accept json
+// @Produce json
+// @Param id path string true "Return ID"
+// @Success 200 {object} models.ReturnAuthorization
+// @Failure 404 {object} map[string]string
+// @Router /returns/{id} [get]
+func GetReturnByID(c *gin.Context) {
+	var rma models.ReturnAuthorization
+	db := utils.ConnectDatabase()
+	if err := preloadReturn(db).First(&rma, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "return authorization not found"})
+		return
+	}
+	c.JSON(http.StatusOK, rma)
+}
+
+// CreateReturn handles POST /returns requests to authorize a customer return.
+// @Summary Authorize a return
+// @Description Create a return authorization (RMA). Against a sales order, no more units can be authorized than were shipped on it.
+// @Tags returns
+// @Accept json
+// @Produce json
+// @Param return body CreateReturnRequest true "Return to authorize"
+// @Success 201 {object} models.ReturnAuthorization
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /returns [post]
+func CreateReturn(c *gin.Context) {
+	var input CreateReturnRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rma := models.ReturnAuthorization{
+		Customer:  input.Customer,
+		Reason:    input.Reason,
+		CreatedBy: currentActor(c),
+	}
+	if input.SalesOrderID != "" {
+		rma.SalesOrderID = &input.SalesOrderID
+	}
+	lines := make([]services.ReturnLineInput, len(input.Lines))
+	for i, line := range input.Lines {
+		lines[i] = services.ReturnLineInput{ItemID: line.ItemID, Quantity: line.Quantity}
+	}
+
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return services.CreateReturn(tx, &rma, lines)
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, rma)
+}
+
+// ReceiveReturn handles POST /returns/:id/receive requests.
+// @Summary Receive returned units
+// @Description Book returned units per line as restock (back into sellable stock at location_id or the default location), quarantine (held apart from stock) or scrap.
+// @Tags returns
+// @Accept json
+// @Produce json
+// @Param id path string true "Return ID"
+// @Param receipt body ReturnDispositionsRequest true "Units received and their disposition"
+// @Success 200 {object} models.ReturnAuthorization
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /returns/{id}/receive [post]
+func ReceiveReturn(c *gin.Context) {
+	dispositionReturn(c, services.ReceiveReturn)
+}
+
+// ReleaseQuarantine handles POST /returns/:id/release requests.
+// @Summary Release quarantined units
+// @Description Settle units held in quarantine by restocking or scrapping them.
+// @Tags returns
+// @Accept json
+// @Produce json
+// @Param id path string true "Return ID"
+// @Param release body ReturnDispositionsRequest true "Quarantined units and their disposition (restock or scrap)"
+// @Success 200 {object} models.ReturnAuthorization
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /returns/{id}/release [post]
+func ReleaseQuarantine(c *gin.Context) {
+	dispositionReturn(c, services.ReleaseQuarantine)
+}
+
+// CancelReturn handles POST /returns/:id/cancel requests.
+// @Summary Cancel a return
+// @Description Withdraw a return authorization nothing has been received against.
+// @Tags returns
+// @Accept json
+// @Produce json
+// @Param id path string true "Return ID"
+// @Success 200 {object} models.ReturnAuthorization
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /returns/{id}/cancel [post]
+func CancelReturn(c *gin.Context) {
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		_, err := services.CancelReturn(tx, c.Param("id"))
+		return err
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	respondWithReturn(c, db)
+}
+
+func dispositionReturn(c *gin.Context, apply func(tx *gorm.DB, id string, inputs []services.DispositionInput, locationID *string, actor string) (*models.ReturnAuthorization, error)) {
+	var input ReturnDispositionsRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	dispositions := make([]services.DispositionInput, len(input.Lines))
+	for i, line := range input.Lines {
+		dispositions[i] = services.DispositionInput{
+			LineID:      line.LineID,
+			Quantity:    line.Quantity,
+			Disposition: line.Disposition,
+			Note:        line.Note,
+		}
+	}
+	var locationID *string
+	if input.LocationID != "" {
+		locationID = &input.LocationID
+	}
+
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		_, err := apply(tx, c.Param("id"), dispositions, locationID, currentActor(c))
+		return err
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	respondWithReturn(c, db)
+}
+
+// respondWithReturn reloads the return so the response carries its full
+// disposition history.
+func respondWithReturn(c *gin.Context, db *gorm.DB) {
+	var rma models.ReturnAuthorization
+	if err := preloadReturn(db).First(&rma, "id = ?", c.Param("id")).Error; err != nil {
+		respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, rma)
+}
+
+func preloadReturn(db *gorm.DB) *gorm.DB {
+	return db.Preload("Lines", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position asc")
+	}).Preload("Dispositions", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at asc")
+	})
+}
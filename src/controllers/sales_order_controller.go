@@ -0,0 +1,224 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+	"inventory-service/src/services"
+	"inventory-service/src/utils"
+)
+
+// SalesOrderLineRequest defines one item ordered on a sales order.
+type SalesOrderLineRequest struct {
+	ItemID   string `json:"item_id" binding:"required" example:"5d3c0a2e-7b1f-4e8a-9c6d-1f2e3a4b5c6d"`
+	Quantity int    `json:"quantity" binding:"required,gt=0" example:"3"`
+}
+
+// CreateSalesOrderRequest defines the payload required to place a sales order.
+type CreateSalesOrderRequest struct {
+	Customer  string                  `json:"customer" binding:"required,max=255" example:"Nora Retail"`
+	Reference string                  `json:"reference" binding:"max=255" example:"SO-2024-0107"`
+	ShipTo    string                  `json:"ship_to" example:"12 King Fahd Rd, Riyadh"`
+	Lines     []SalesOrderLineRequest `json:"lines" binding:"required,min=1,dive"`
+	Allocate  bool                    `json:"allocate" example:"true"`
+}
+
+// GetSalesOrders handles GET /sales-orders requests and returns sales orders.
+// @Summary List sales orders
+// @Description Retrieve sales orders with their lines, newest first.
+// @Tags sales-orders
+// @Accept json
+// @Produce json
+// @Param status query string false "Filter by status (pending|backordered|allocated|picked|shipped|cancelled)"
+// @Param customer query string false "Filter by customer (case-insensitive)"
+// @Param item_id query string false "Only orders with a line for this item"
+// @Param limit query int false "Orders per page (default 10, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {array} models.SalesOrder
+// @Failure 500 {object} map[string]string
+// @Router /sales-orders [get]
+func GetSalesOrders(c *gin.Context) {
+	limit, offset := paginate(c)
+
+	db := utils.ConnectDatabase()
+	query := preloadSalesOrder(db)
+	if status := c.Query("status"); status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if customer := c.Query("customer"); customer != "" {
+		query = query.Where("customer ILIKE ?", "%"+customer+"%")
+	}
+	if itemID := c.Query("item_id"); itemID != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM sales_order_lines WHERE sales_order_lines.sales_order_id = sales_orders.id AND sales_order_lines.item_id = ?)", itemID)
+	}
+
+	var orders []models.SalesOrder
+	if err := query.Order("created_at desc").Limit(limit).Offset(offset).Find(&orders).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, orders)
+}
+
+// GetSalesOrderByID handles GET /sales-orders/:id requests and returns the matching order.
+// @Summary Get a sales order
+// @Description Retrieve a single sales order with its lines.
+// @Tags sales-orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Sales order ID"
+// @Success 200 {object} models.SalesOrder
+// @Failure 404 {object} map[string]string
+// @Router /sales-orders/{id} [get]
+func GetSalesOrderByID(c *gin.Context) {
+	var order models.SalesOrder
+	db := utils.ConnectDatabase()
+	if err := preloadSalesOrder(db).First(&order, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "sales order not found"})
+		return
+	}
+	c.JSON(http.StatusOK, order)
+}
+
+// CreateSalesOrder handles POST /sales-orders requests to place a new order.
+// @Summary Create a sales order
+// @Description Place a pending sales order. With allocate set, available stock is reserved for it straight away.
+// @Tags sales-orders
+// @Accept json
+// @Produce json
+// @Param order body CreateSalesOrderRequest true "Order to place"
+// @Success 201 {object} models.SalesOrder
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /sales-orders [post]
+func CreateSalesOrder(c *gin.Context) {
+	var input CreateSalesOrderRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	order := &models.SalesOrder{
+		Customer:  input.Customer,
+		Reference: input.Reference,
+		ShipTo:    input.ShipTo,
+		CreatedBy: currentActor(c),
+	}
+	lines := make([]services.SalesLineInput, len(input.Lines))
+	for i, line := range input.Lines {
+		lines[i] = services.SalesLineInput{ItemID: line.ItemID, Quantity: line.Quantity}
+	}
+
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := services.CreateSalesOrder(tx, order, lines); err != nil {
+			return err
+		}
+		if !input.Allocate {
+			return nil
+		}
+		var err error
+		order, err = services.AllocateSalesOrder(tx, order.ID)
+		return err
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, order)
+}
+
+// AllocateSalesOrder handles POST /sales-orders/:id/allocate requests.
+// @Summary Allocate a sales order
+// @Description Reserve available stock for every unit not yet allocated or shipped. Units that cannot be filled stay on backorder; allocate again once stock arrives.
+// @Tags sales-orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Sales order ID"
+// @Success 200 {object} models.SalesOrder
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /sales-orders/{id}/allocate [post]
+func AllocateSalesOrder(c *gin.Context) {
+	changeSalesOrder(c, services.AllocateSalesOrder)
+}
+
+// PickSalesOrder handles POST /sales-orders/:id/pick requests.
+// @Summary Pick a sales order
+// @Description Stage every allocated unit for the next shipment.
+// @Tags sales-orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Sales order ID"
+// @Success 200 {object} models.SalesOrder
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /sales-orders/{id}/pick [post]
+func PickSalesOrder(c *gin.Context) {
+	changeSalesOrder(c, services.PickSalesOrder)
+}
+
+// ShipSalesOrder handles POST /sales-orders/:id/ship requests.
+// @Summary Ship a sales order
+// @Description Ship every picked unit, removing it from stock with a sale movement. Backordered units stay open on the order.
+// @Tags sales-orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Sales order ID"
+// @Success 200 {object} models.SalesOrder
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /sales-orders/{id}/ship [post]
+func ShipSalesOrder(c *gin.Context) {
+	changeSalesOrder(c, func(tx *gorm.DB, id string) (*models.SalesOrder, error) {
+		return services.ShipSalesOrder(tx, id, currentActor(c))
+	})
+}
+
+// CancelSalesOrder handles POST /sales-orders/:id/cancel requests.
+// @Summary Cancel a sales order
+// @Description Close an order that is not fully shipped and return its allocated units to available stock.
+// @Tags sales-orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Sales order ID"
+// @Success 200 {object} models.SalesOrder
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /sales-orders/{id}/cancel [post]
+func CancelSalesOrder(c *gin.Context) {
+	changeSalesOrder(c, services.CancelSalesOrder)
+}
+
+func changeSalesOrder(c *gin.Context, change func(tx *gorm.DB, id string) (*models.SalesOrder, error)) {
+	db := utils.ConnectDatabase()
+
+	var order *models.SalesOrder
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		order, err = change(tx, c.Param("id"))
+		return err
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, order)
+}
+
+func preloadSalesOrder(db *gorm.DB) *gorm.DB {
+	return db.Preload("Lines", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position asc")
+	})
+}
@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+
+	"inventory-service/src/events"
+	"inventory-service/src/models"
+	"inventory-service/src/services"
+	"inventory-service/src/utils"
+)
+
+// StreamItems handles GET /inventory/stream requests with a Server-Sent Events stream of changes.
+// @Summary Stream inventory changes
+// @Description Push item.created, item.updated, item.deleted, stock.changed, stock.reserved and alert.* events as Server-Sent Events named after their type, with the event envelope as data.
+// @Description Each event's id is its position in the event stream; reconnecting with Last-Event-ID (or last_event_id) first replays the events published since then, including those that committed late with a lower sequence. A comment is sent every 15 seconds to keep idle connections open.
+// @Tags inventory
+// @Produce text/event-stream
+// @Param item_id query string false "Only events about this item (repeatable)"
+// @Param name query string false "Only events about items whose name contains this, ignoring case"
+// @Param Last-Event-ID header string false "Resume after this event position"
+// @Param last_event_id query int false "Resume after this event position when the header cannot be set"
+// @Success 200 {object} models.OutboxEvent "Stream of events"
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/stream [get]
+func StreamItems(c *gin.Context) {
+	filter := events.Filter{ItemIDs: c.QueryArray("item_id"), Name: c.Query("name")}
+
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("last_event_id")
+	}
+	var after int64
+	if lastEventID != "" {
+		var err error
+		if after, err = strconv.ParseInt(lastEventID, 10, 64); err != nil || after < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Last-Event-ID must be an event position"})
+			return
+		}
+	}
+
+	sub := events.Subscribe()
+	defer sub.Close()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+
+	var sent services.Watermark
+	if lastEventID != "" {
+		var err error
+		sent, err = services.ReplayAfter(utils.ConnectDatabase(), after, filter, func(event models.OutboxEvent) error {
+			renderEvent(c, event)
+			return nil
+		})
+		if err != nil {
+			if !c.Writer.Written() {
+				c.Writer.Header().Del("Content-Type")
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			}
+			return
+		}
+	}
+
+	c.Writer.WriteHeaderNow()
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(15 * time.Second)
+	defer heartbeat.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case <-heartbeat.C:
+			_, err := io.WriteString(w, ": ping\n\n")
+			return err == nil
+		case event, ok := <-sub.C:
+			// Closed on shutdown or after falling behind; the client reconnects
+			// with Last-Event-ID and catches up
+			if !ok {
+				return false
+			}
+			if !sent.Seen(event) && filter.Match(event) {
+				renderEvent(c, event)
+			}
+			return true
+		}
+	})
+}
+
+func renderEvent(c *gin.Context, event models.OutboxEvent) {
+	c.Render(-1, sse.Event{
+		Id:    strconv.FormatInt(*event.Position, 10),
+		Event: event.Type,
+		Data:  event,
+	})
+}
@@ -0,0 +1,428 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+	"inventory-service/src/services"
+	"inventory-service/src/utils"
+)
+
+// CreateSupplierRequest defines the payload required to create a new supplier.
+type CreateSupplierRequest struct {
+	Name         string `json:"name" binding:"required,max=255" example:"Acme Components"`
+	ContactName  string `json:"contact_name" example:"Sara Ali"`
+	Email        string `json:"email" binding:"omitempty,email" example:"orders@acme.example"`
+	Phone        string `json:"phone" binding:"max=64" example:"+966 11 000 0000"`
+	Address      string `json:"address" example:"7 Industrial Rd, Riyadh"`
+	LeadTimeDays int    `json:"lead_time_days" binding:"min=0" example:"14"`
+	Currency     string `json:"currency" binding:"omitempty,iso4217" example:"USD"`
+}
+
+// UpdateSupplierRequest defines the fields that can be updated on a supplier.
+type UpdateSupplierRequest struct {
+	Name         *string `json:"name" binding:"omitempty,max=255" example:"Acme Components Ltd"`
+	ContactName  *string `json:"contact_name" example:"Omar Saleh"`
+	Email        *string `json:"email" binding:"omitempty,email" example:"purchasing@acme.example"`
+	Phone        *string `json:"phone" binding:"omitempty,max=64" example:"+966 11 000 0001"`
+	Address      *string `json:"address" example:"9 Industrial Rd, Riyadh"`
+	LeadTimeDays *int    `json:"lead_time_days" binding:"omitempty,min=0" example:"10"`
+	Currency     *string `json:"currency" binding:"omitempty,iso4217" example:"EUR"`
+}
+
+// ItemSupplierRequest defines the terms on which a supplier sells an item.
+type ItemSupplierRequest struct {
+	SupplierSKU      string           `json:"supplier_sku" binding:"max=64" example:"AC-LP-1001"`
+	UnitCost         *decimal.Decimal `json:"unit_cost" binding:"required" swaggertype:"string" example:"812.50"`
+	MinOrderQuantity int              `json:"min_order_quantity" binding:"omitempty,gt=0" example:"5"`
+	Preferred        bool             `json:"preferred" example:"true"`
+}
+
+// GetSuppliers handles GET /suppliers requests and returns all suppliers.
+// @Summary List suppliers
+// @Description Retrieve the vendors items are bought from, ordered by name.
+// @Tags suppliers
+// @Accept json
+// @Produce json
+// @Param name query string false "Filter by supplier name (case-insensitive)"
+// @Param limit query int false "Suppliers per page (default 10, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {array} models.Supplier
+// @Failure 500 {object} map[string]string
+// @Router /suppliers [get]
+func GetSuppliers(c *gin.Context) {
+	limit, offset := paginate(c)
+
+	db := utils.ConnectDatabase()
+	query := db.Model(&models.Supplier{})
+	if name := c.Query("name"); name != "" {
+		query = query.Where("name ILIKE ?", "%"+name+"%")
+	}
+
+	var suppliers []models.Supplier
+	if err := query.Order("name asc").Limit(limit).Offset(offset).Find(&suppliers).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, suppliers)
+}
+
+// GetSupplierByID handles GET /suppliers/:id requests and returns the matching supplier.
+// @Summary Get a supplier
+// @Description Retrieve a single supplier by its identifier.
+// @Tags suppliers
+// @Accept json
+// @Produce json
+// @Param id path string true "Supplier ID"
+// @Success 200 {object} models.Supplier
+// @Failure 404 {object} map[string]string
+// @Router /suppliers/{id} [get]
+func GetSupplierByID(c *gin.Context) {
+	var supplier models.Supplier
+	db := utils.ConnectDatabase()
+	if err := db.First(&supplier, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "supplier not found"})
+		return
+	}
+	c.JSON(http.StatusOK, supplier)
+}
+
+// CreateSupplier handles POST /suppliers requests to add a new supplier.
+// @Summary Create a supplier
+// @Description Create a new supplier with contact details, lead time and the currency it invoices in (default USD).
+// @Tags suppliers
+// @Accept json
+// @Produce json
+// @Param supplier body CreateSupplierRequest true "Supplier to create"
+// @Success 201 {object} models.Supplier
+// @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /suppliers [post]
+func CreateSupplier(c *gin.Context) {
+	var input CreateSupplierRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	supplier := models.Supplier{
+		Name:         input.Name,
+		ContactName:  input.ContactName,
+		Email:        input.Email,
+		Phone:        input.Phone,
+		Address:      input.Address,
+		LeadTimeDays: input.LeadTimeDays,
+		Currency:     input.Currency,
+	}
+	if supplier.Currency == "" {
+		supplier.Currency = models.DefaultCurrency
+	}
+
+	db := utils.ConnectDatabase()
+	if err := db.Create(&supplier).Error; err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, supplier)
+}
+
+// UpdateSupplier handles PUT /suppliers/:id requests to modify an existing supplier.
+// @Summary Update a supplier
+// @Description Update the mutable fields of a supplier. The currency cannot change while items are linked, since their unit costs are quoted in it.
+// @Tags suppliers
+// @Accept json
+// @Produce json
+// @Param id path string true "Supplier ID"
+// @Param supplier body UpdateSupplierRequest true "Fields to update"
+// @Success 200 {object} models.Supplier
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /suppliers/{id} [put]
+func UpdateSupplier(c *gin.Context) {
+	var supplier models.Supplier
+	db := utils.ConnectDatabase()
+	if err := db.First(&supplier, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "supplier not found"})
+		return
+	}
+
+	var payload UpdateSupplierRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if payload.Name != nil {
+		supplier.Name = *payload.Name
+	}
+	if payload.ContactName != nil {
+		supplier.ContactName = *payload.ContactName
+	}
+	if payload.Email != nil {
+		supplier.Email = *payload.Email
+	}
+	if payload.Phone != nil {
+		supplier.Phone = *payload.Phone
+	}
+	if payload.Address != nil {
+		supplier.Address = *payload.Address
+	}
+	if payload.LeadTimeDays != nil {
+		supplier.LeadTimeDays = *payload.LeadTimeDays
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if payload.Currency != nil && *payload.Currency != supplier.Currency {
+			var links int64
+			if err := tx.Model(&models.ItemSupplier{}).Where("supplier_id = ?", supplier.ID).Count(&links).Error; err != nil {
+				return err
+			}
+			if links > 0 {
+				return services.ErrSupplierCurrencyLocked
+			}
+			supplier.Currency = *payload.Currency
+		}
+		return tx.Save(&supplier).Error
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, supplier)
+}
+
+// DeleteSupplier handles DELETE /suppliers/:id requests to remove a supplier.
+// @Summary Delete a supplier
+// @Description Remove a supplier and its links to items. Suppliers with purchase orders cannot be deleted.
+// @Tags suppliers
+// @Accept json
+// @Produce json
+// @Param id path string true "Supplier ID"
+// @Success 204 {string} string "No Content"
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /suppliers/{id} [delete]
+func DeleteSupplier(c *gin.Context) {
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return services.DeleteSupplier(tx, c.Param("id"))
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetSupplierItems handles GET /suppliers/:id/items requests and returns the items a supplier sells.
+// @Summary List a supplier's items
+// @Description Retrieve the items a supplier sells with its SKU, unit cost and minimum order quantity for each.
+// @Tags suppliers
+// @Accept json
+// @Produce json
+// @Param id path string true "Supplier ID"
+// @Param limit query int false "Links per page (default 10, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {array} models.ItemSupplier
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /suppliers/{id}/items [get]
+func GetSupplierItems(c *gin.Context) {
+	var supplier models.Supplier
+	db := utils.ConnectDatabase()
+	if err := db.First(&supplier, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "supplier not found"})
+		return
+	}
+
+	limit, offset := paginate(c)
+
+	// Deleted items keep their links but are not listed
+	var links []models.ItemSupplier
+	err := db.Preload("Item").
+		Joins("JOIN items ON items.id = item_suppliers.item_id AND items.deleted_at IS NULL").
+		Where("item_suppliers.supplier_id = ?", supplier.ID).
+		Order("items.name asc").
+		Limit(limit).Offset(offset).
+		Find(&links).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, links)
+}
+
+// GetPreferredSuppliers handles GET /suppliers/preferred requests and lists each item's preferred supplier.
+// @Summary List preferred suppliers
+// @Description Retrieve the preferred supplier link of every item that has one, with the item and supplier, ordered by item name.
+// @Tags suppliers
+// @Accept json
+// @Produce json
+// @Param limit query int false "Links per page (default 10, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {array} models.ItemSupplier
+// @Failure 500 {object} map[string]string
+// @Router /suppliers/preferred [get]
+func GetPreferredSuppliers(c *gin.Context) {
+	limit, offset := paginate(c)
+
+	var links []models.ItemSupplier
+	db := utils.ConnectDatabase()
+	err := db.Preload("Item").Preload("Supplier").
+		Joins("JOIN items ON items.id = item_suppliers.item_id AND items.deleted_at IS NULL").
+		Where("item_suppliers.preferred").
+		Order("items.name asc").
+		Limit(limit).Offset(offset).
+		Find(&links).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, links)
+}
+
+// GetItemSuppliers handles GET /inventory/:id/suppliers requests and returns the item's suppliers.
+// @Summary List an item's suppliers
+// @Description Retrieve every supplier of an item, the preferred one first, then by unit cost.
+// @Tags suppliers
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Success 200 {array} models.ItemSupplier
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/{id}/suppliers [get]
+func GetItemSuppliers(c *gin.Context) {
+	var item models.Item
+	db := utils.ConnectDatabase()
+	if err := db.First(&item, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "item not found"})
+		return
+	}
+
+	var links []models.ItemSupplier
+	err := db.Preload("Supplier").
+		Where("item_id = ?", item.ID).
+		Order("preferred desc, unit_cost asc").
+		Find(&links).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, links)
+}
+
+// GetItemPreferredSupplier handles GET /inventory/:id/suppliers/preferred requests.
+// @Summary Get an item's preferred supplier
+// @Description Retrieve the supplier link marked preferred for an item.
+// @Tags suppliers
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Success 200 {object} models.ItemSupplier
+// @Failure 404 {object} map[string]string
+// @Router /inventory/{id}/suppliers/preferred [get]
+func GetItemPreferredSupplier(c *gin.Context) {
+	var item models.Item
+	db := utils.ConnectDatabase()
+	if err := db.First(&item, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "item not found"})
+		return
+	}
+
+	var link models.ItemSupplier
+	if err := db.Preload("Supplier").First(&link, "item_id = ? AND preferred", item.ID).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "item has no preferred supplier"})
+		return
+	}
+
+	c.JSON(http.StatusOK, link)
+}
+
+// SetItemSupplier handles PUT /inventory/:id/suppliers/:supplier_id requests to link an item to a supplier.
+// @Summary Link an item to a supplier
+// @Description Create or replace the terms on which a supplier sells an item. The unit cost is in the supplier's currency; marking the link preferred clears the flag on the item's other suppliers.
+// @Tags suppliers
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Param supplier_id path string true "Supplier ID"
+// @Param link body ItemSupplierRequest true "Supply terms"
+// @Success 200 {object} models.ItemSupplier
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/{id}/suppliers/{supplier_id} [put]
+func SetItemSupplier(c *gin.Context) {
+	var input ItemSupplierRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	link := models.ItemSupplier{
+		ItemID:           c.Param("id"),
+		SupplierID:       c.Param("supplier_id"),
+		SupplierSKU:      input.SupplierSKU,
+		UnitCost:         *input.UnitCost,
+		MinOrderQuantity: input.MinOrderQuantity,
+		Preferred:        input.Preferred,
+	}
+	if link.MinOrderQuantity == 0 {
+		link.MinOrderQuantity = 1
+	}
+
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return services.SaveItemSupplier(tx, &link)
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, link)
+}
+
+// RemoveItemSupplier handles DELETE /inventory/:id/suppliers/:supplier_id requests to unlink a supplier.
+// @Summary Unlink an item from a supplier
+// @Description Remove a supplier from the item's suppliers.
+// @Tags suppliers
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Param supplier_id path string true "Supplier ID"
+// @Success 204 {string} string "No Content"
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/{id}/suppliers/{supplier_id} [delete]
+func RemoveItemSupplier(c *gin.Context) {
+	db := utils.ConnectDatabase()
+	result := db.Where("item_id = ? AND supplier_id = ?", c.Param("id"), c.Param("supplier_id")).
+		Delete(&models.ItemSupplier{})
+	if result.Error != nil {
+		respondError(c, result.Error)
+		return
+	}
+	if result.RowsAffected == 0 {
+		respondError(c, services.ErrItemSupplierNotFound)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
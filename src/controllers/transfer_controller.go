@@ -0,0 +1,197 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+	"inventory-service/src/services"
+	"inventory-service/src/utils"
+)
+
+// CreateTransferRequest defines the payload required to ship stock between locations.
+type CreateTransferRequest struct {
+	FromLocationID string `json:"from_location_id" binding:"required" example:"3f1c9a4e-8d2b-4c7e-9a61-2b5d0e7f4a10"`
+	ToLocationID   string `json:"to_location_id" binding:"required" example:"9b7e2d10-4a3c-4f8e-b1d2-6c5a8e9f0b21"`
+	Quantity       int    `json:"quantity" binding:"required,gt=0" example:"20"`
+	Reference      string `json:"reference" example:"rebalance-week-42"`
+}
+
+// ReceiveTransferRequest defines the payload for booking in-transit units at the destination.
+type ReceiveTransferRequest struct {
+	Quantity int `json:"quantity" binding:"required,gt=0" example:"5"`
+}
+
+// GetTransfers handles GET /inventory/:id/transfers requests and returns the item's transfer orders.
+// @Summary List transfer orders
+// @Description Retrieve the inter-location transfer orders of an item, newest first.
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Param status query string false "Filter by status (in_transit|partially_received|received|cancelled)"
+// @Param limit query int false "Transfers per page (default 10, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {array} models.TransferOrder
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/{id}/transfers [get]
+func GetTransfers(c *gin.Context) {
+	id := c.Param("id")
+	db := utils.ConnectDatabase()
+
+	var item models.Item
+	if err := db.First(&item, "id = ?", id).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "item not found"})
+		return
+	}
+
+	query := db.Where("item_id = ?", id)
+	if status := c.Query("status"); status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	limit, offset := paginate(c)
+
+	var transfers []models.TransferOrder
+	if err := query.Order("created_at desc").Limit(limit).Offset(offset).Find(&transfers).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, transfers)
+}
+
+// GetTransferByID handles GET /inventory/:id/transfers/:transfer_id requests.
+// @Summary Get a transfer order
+// @Description Retrieve a single transfer order of an item.
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Param transfer_id path string true "Transfer order ID"
+// @Success 200 {object} models.TransferOrder
+// @Failure 404 {object} map[string]string
+// @Router /inventory/{id}/transfers/{transfer_id} [get]
+func GetTransferByID(c *gin.Context) {
+	var transfer models.TransferOrder
+	db := utils.ConnectDatabase()
+	if err := db.First(&transfer, "id = ? AND item_id = ?", c.Param("transfer_id"), c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "transfer order not found"})
+		return
+	}
+	c.JSON(http.StatusOK, transfer)
+}
+
+// CreateTransfer handles POST /inventory/:id/transfers requests to ship stock between locations.
+// @Summary Ship a transfer order
+// @Description Ship units of an item out of the source location; they stay in transit until received at the destination.
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Param transfer body CreateTransferRequest true "Transfer to ship"
+// @Success 201 {object} models.TransferOrder
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/{id}/transfers [post]
+func CreateTransfer(c *gin.Context) {
+	var input CreateTransferRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	transfer := models.TransferOrder{
+		ItemID:         c.Param("id"),
+		FromLocationID: input.FromLocationID,
+		ToLocationID:   input.ToLocationID,
+		Quantity:       input.Quantity,
+		Reference:      input.Reference,
+		CreatedBy:      currentActor(c),
+	}
+
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return services.ShipTransfer(tx, &transfer)
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, transfer)
+}
+
+// ReceiveTransfer handles POST /inventory/:id/transfers/:transfer_id/receive requests.
+// @Summary Receive a transfer order
+// @Description Book in-transit units into the destination location. Partial receipts are allowed.
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Param transfer_id path string true "Transfer order ID"
+// @Param receipt body ReceiveTransferRequest true "Units received"
+// @Success 200 {object} models.TransferOrder
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/{id}/transfers/{transfer_id}/receive [post]
+func ReceiveTransfer(c *gin.Context) {
+	var input ReceiveTransferRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	changeTransfer(c, func(tx *gorm.DB, id string) (*models.TransferOrder, error) {
+		return services.ReceiveTransfer(tx, id, input.Quantity, currentActor(c))
+	})
+}
+
+// CancelTransfer handles POST /inventory/:id/transfers/:transfer_id/cancel requests.
+// @Summary Cancel a transfer order
+// @Description Return every unit still in transit to the source location.
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Param transfer_id path string true "Transfer order ID"
+// @Success 200 {object} models.TransferOrder
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/{id}/transfers/{transfer_id}/cancel [post]
+func CancelTransfer(c *gin.Context) {
+	changeTransfer(c, func(tx *gorm.DB, id string) (*models.TransferOrder, error) {
+		return services.CancelTransfer(tx, id, currentActor(c))
+	})
+}
+
+func changeTransfer(c *gin.Context, change func(tx *gorm.DB, id string) (*models.TransferOrder, error)) {
+	db := utils.ConnectDatabase()
+
+	var existing models.TransferOrder
+	if err := db.First(&existing, "id = ? AND item_id = ?", c.Param("transfer_id"), c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "transfer order not found"})
+		return
+	}
+
+	var transfer *models.TransferOrder
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		transfer, err = change(tx, existing.ID)
+		return err
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, transfer)
+}
@@ -0,0 +1,96 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+	"inventory-service/src/services"
+	"inventory-service/src/utils"
+)
+
+// GetTrash handles GET /inventory/trash requests and returns soft-deleted items.
+// @Summary List deleted items
+// @Description Retrieve soft-deleted inventory items, most recently deleted first.
+// @Tags trash
+// @Accept json
+// @Produce json
+// @Param limit query int false "Items per page (default 10, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {array} models.Item
+// @Failure 500 {object} map[string]string
+// @Router /inventory/trash [get]
+func GetTrash(c *gin.Context) {
+	limit, offset := paginate(c)
+
+	var items []models.Item
+	db := utils.ConnectDatabase()
+	err := db.Unscoped().
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at desc").
+		Limit(limit).Offset(offset).
+		Find(&items).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, items)
+}
+
+// RestoreItem handles POST /inventory/:id/restore requests to undo a delete.
+// @Summary Restore a deleted item
+// @Description Bring a soft-deleted inventory item back into the inventory.
+// @Tags trash
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Success 200 {object} models.Item
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/{id}/restore [post]
+func RestoreItem(c *gin.Context) {
+	var item *models.Item
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		item, err = services.RestoreItem(tx, c.Param("id"), auditContext(c))
+		return err
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Header("ETag", itemETag(*item))
+	c.JSON(http.StatusOK, item)
+}
+
+// PurgeItem handles DELETE /inventory/trash/:id requests to permanently remove a deleted item.
+// @Summary Purge a deleted item
+// @Description Permanently remove a soft-deleted item with its stock levels, closed reservations, supplier links and alert rules. Items referenced by stock movements, transfers, purchase or sales orders or returns are refused with 409, since those records must keep their lines. Its audit and alert history is kept. Requires the admin token.
+// @Tags trash
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID"
+// @Param X-Admin-Token header string true "Admin token"
+// @Success 204 {string} string "No Content"
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/trash/{id} [delete]
+func PurgeItem(c *gin.Context) {
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return services.PurgeItem(tx, c.Param("id"), auditContext(c))
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
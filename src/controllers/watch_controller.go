@@ -0,0 +1,412 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/shopspring/decimal"
+	"golang.org/x/time/rate"
+
+	"inventory-service/src/events"
+	"inventory-service/src/middlewares"
+	"inventory-service/src/models"
+	"inventory-service/src/services"
+	"inventory-service/src/utils"
+)
+
+// Limits and timings of /inventory/watch connections
+const (
+	maxWatchConnections = 5
+	maxWatchedItems     = 100
+	watchSendBuffer     = 64
+	watchWriteWait      = 10 * time.Second
+	watchPongWait       = 60 * time.Second
+	watchPingPeriod     = 25 * time.Second
+	watchMaxMessageSize = 8 << 10
+)
+
+// Watch commands and message types
+const (
+	watchSubscribe   = "subscribe"
+	watchUnsubscribe = "unsubscribe"
+
+	watchSubscribed = "subscribed"
+	watchSnapshot   = "snapshot"
+	watchStock      = "stock"
+	watchPrice      = "price"
+	watchDeleted    = "deleted"
+	watchError      = "error"
+)
+
+// The API allows every origin (see the CORS setup in main), so browsers may connect
+// from anywhere as well
+var watchUpgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// WatchCommand is a message from a watch client.
+type WatchCommand struct {
+	Action  string   `json:"action" example:"subscribe"`
+	ItemIDs []string `json:"item_ids" example:"5d3c0a2e-7b1f-4e8a-9c6d-1f2e3a4b5c6d"`
+}
+
+// WatchMessage is a message to a watch client. Type decides which fields are set:
+// subscribed lists item_ids; snapshot carries stock, available, price and
+// currency; stock carries stock and available, and delta when stock itself
+// changed rather than the units held for reservations and sales orders; price
+// carries price and currency; error carries error. Snapshots and changes carry
+// the event stream position they reflect, and an item's changes follow its
+// snapshot in position order.
+type WatchMessage struct {
+	Type      string           `json:"type" example:"stock"`
+	ItemID    string           `json:"item_id,omitempty"`
+	ItemIDs   []string         `json:"item_ids,omitempty"`
+	Position  int64            `json:"position,omitempty" example:"1042"`
+	Delta     *int             `json:"delta,omitempty" example:"-1"`
+	Stock     *int             `json:"stock,omitempty" example:"41"`
+	Available *int             `json:"available,omitempty" example:"39"`
+	Price     *decimal.Decimal `json:"price,omitempty" swaggertype:"string" example:"999.99"`
+	Currency  string           `json:"currency,omitempty" example:"USD"`
+	Error     string           `json:"error,omitempty"`
+}
+
+// WatchItems handles GET /inventory/watch WebSocket connections.
+// @Summary Watch item stock and prices
+// @Description Upgrade to a WebSocket. Send {"action":"subscribe"|"unsubscribe","item_ids":[...]} to choose items (at most 100); each subscribed item is answered with a snapshot at the current event position, then stock messages carry every later change to stock or available stock and price messages every later price change. Changes arrive within about a second, the interval at which the outbox relay publishes events.
+// @Description The server pings every 25 seconds and drops clients that stop answering or fall too far behind. Each client IP may hold 5 connections across all replicas and send 5 commands a second.
+// @Tags inventory
+// @Success 101 {object} WatchMessage "Switching Protocols"
+// @Failure 400 {object} map[string]string
+// @Failure 429 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /inventory/watch [get]
+func WatchItems(c *gin.Context) {
+	// X-User-ID is whatever the client claims, so the limit is per address
+	slot, err := middlewares.AcquireConnectionSlot(c.Request.Context(), "watch", c.ClientIP(), maxWatchConnections)
+	if errors.Is(err, middlewares.ErrTooManyConnections) {
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "connection limiter error"})
+		return
+	}
+	defer slot.Release(context.Background())
+
+	// The upgrader answers failed handshakes itself
+	conn, err := watchUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return
+	}
+
+	w := &watcher{
+		conn:     conn,
+		slot:     slot,
+		items:    map[string]*models.Item{},
+		since:    map[string]int64{},
+		send:     make(chan WatchMessage, watchSendBuffer),
+		commands: make(chan watchRequest),
+		done:     make(chan struct{}),
+		stop:     make(chan struct{}),
+	}
+	w.run()
+}
+
+// watcher serves one watch connection. run owns the subscriptions, with the
+// position of each item's snapshot in since; readCommands and writeMessages are
+// the connection's single reader and writer. done is closed when the reader stops
+// and stop when run does.
+type watcher struct {
+	conn     *websocket.Conn
+	slot     *middlewares.ConnectionSlot
+	items    map[string]*models.Item
+	since    map[string]int64
+	send     chan WatchMessage
+	commands chan watchRequest
+	done     chan struct{}
+	stop     chan struct{}
+}
+
+// watchRequest is a command read from the client, or why it could not be read.
+type watchRequest struct {
+	command WatchCommand
+	err     string
+}
+
+func (w *watcher) run() {
+	sub := events.Subscribe()
+	defer sub.Close()
+
+	writerDone := make(chan struct{})
+	go func() {
+		w.writeMessages()
+		close(writerDone)
+	}()
+	go w.readCommands()
+
+	closeCode, closeText := websocket.CloseNormalClosure, ""
+	heartbeat := time.NewTicker(watchPingPeriod)
+	defer heartbeat.Stop()
+
+loop:
+	for {
+		select {
+		case <-w.done:
+			break loop
+		case <-writerDone:
+			break loop
+		case request := <-w.commands:
+			if !w.handleRequest(request) {
+				closeCode, closeText = websocket.ClosePolicyViolation, "client is not keeping up"
+				break loop
+			}
+		case event, ok := <-sub.C:
+			if !ok {
+				closeCode, closeText = websocket.CloseGoingAway, "server shutting down"
+				if sub.Overflowed() {
+					closeCode, closeText = websocket.CloseTryAgainLater, "fell behind, reconnect"
+				}
+				break loop
+			}
+			if !w.handleEvent(event) {
+				closeCode, closeText = websocket.ClosePolicyViolation, "client is not keeping up"
+				break loop
+			}
+		case <-heartbeat.C:
+			_ = w.slot.Refresh(context.Background())
+		}
+	}
+
+	close(w.stop)
+	<-writerDone
+	message := websocket.FormatCloseMessage(closeCode, closeText)
+	_ = w.conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(watchWriteWait))
+	w.conn.Close()
+}
+
+// handleRequest applies a subscribe or unsubscribe command and reports whether the
+// client is keeping up with the replies.
+func (w *watcher) handleRequest(request watchRequest) bool {
+	if request.err != "" {
+		return w.enqueue(WatchMessage{Type: watchError, Error: request.err})
+	}
+
+	command := request.command
+	switch command.Action {
+	case watchSubscribe:
+		var newIDs, lookupIDs []string
+		seen := map[string]bool{}
+		for _, id := range command.ItemIDs {
+			if _, ok := w.items[id]; ok || seen[id] {
+				continue
+			}
+			seen[id] = true
+			newIDs = append(newIDs, id)
+			// Malformed IDs are reported as not found like any other unknown item
+			if uuid.Validate(id) == nil {
+				lookupIDs = append(lookupIDs, id)
+			}
+		}
+		if len(w.items)+len(newIDs) > maxWatchedItems {
+			return w.enqueue(WatchMessage{Type: watchError, Error: "at most 100 items can be watched per connection"})
+		}
+
+		// Read the position first: items read after it reflect every event up
+		// to it, and those events are dropped when they arrive live
+		var items []models.Item
+		var position int64
+		if len(lookupIDs) > 0 {
+			db := utils.ConnectDatabase()
+			var err error
+			if position, err = services.LatestPosition(db); err == nil {
+				err = db.Where("id IN ?", lookupIDs).Find(&items).Error
+			}
+			if err != nil {
+				return w.enqueue(WatchMessage{Type: watchError, Error: err.Error()})
+			}
+		}
+		for i := range items {
+			item := &items[i]
+			w.items[item.ID] = item
+			w.since[item.ID] = position
+			if !w.enqueue(snapshotMessage(item, position)) {
+				return false
+			}
+		}
+		for _, id := range newIDs {
+			if _, ok := w.items[id]; ok {
+				continue
+			}
+			if !w.enqueue(WatchMessage{Type: watchError, ItemID: id, Error: "item not found"}) {
+				return false
+			}
+		}
+	case watchUnsubscribe:
+		for _, id := range command.ItemIDs {
+			delete(w.items, id)
+			delete(w.since, id)
+		}
+	default:
+		return w.enqueue(WatchMessage{Type: watchError, Error: `action must be "subscribe" or "unsubscribe"`})
+	}
+	return w.enqueue(WatchMessage{Type: watchSubscribed, ItemIDs: w.itemIDs()})
+}
+
+// handleEvent forwards the stock, availability or price change an event describes
+// when its item is watched and the event is newer than its snapshot, and reports
+// whether the client is keeping up.
+func (w *watcher) handleEvent(event models.OutboxEvent) bool {
+	item, ok := w.items[event.AggregateID]
+	if !ok || event.Position == nil || *event.Position <= w.since[item.ID] {
+		return true
+	}
+	w.since[item.ID] = *event.Position
+
+	switch event.Type {
+	case models.EventStockChanged:
+		var change services.StockChange
+		if err := json.Unmarshal(event.Data, &change); err != nil {
+			return true
+		}
+		item.Stock = change.Stock
+		return w.enqueue(WatchMessage{
+			Type:      watchStock,
+			ItemID:    item.ID,
+			Position:  *event.Position,
+			Delta:     &change.Movement.Delta,
+			Stock:     &change.Stock,
+			Available: &change.Available,
+		})
+	case models.EventStockReserved:
+		var change services.ReservedChange
+		if err := json.Unmarshal(event.Data, &change); err != nil {
+			return true
+		}
+		item.Stock = change.Stock
+		return w.enqueue(WatchMessage{
+			Type:      watchStock,
+			ItemID:    item.ID,
+			Position:  *event.Position,
+			Stock:     &change.Stock,
+			Available: &change.Available,
+		})
+	case models.EventItemUpdated:
+		var updated models.Item
+		if err := json.Unmarshal(event.Data, &updated); err != nil {
+			return true
+		}
+		if updated.Price.Equal(item.Price) && updated.Currency == item.Currency {
+			return true
+		}
+		item.Price, item.Currency = updated.Price, updated.Currency
+		return w.enqueue(WatchMessage{
+			Type:     watchPrice,
+			ItemID:   item.ID,
+			Position: *event.Position,
+			Price:    &updated.Price,
+			Currency: updated.Currency,
+		})
+	case models.EventItemDeleted:
+		delete(w.items, item.ID)
+		delete(w.since, item.ID)
+		return w.enqueue(WatchMessage{Type: watchDeleted, ItemID: item.ID, Position: *event.Position})
+	}
+	return true
+}
+
+// enqueue hands msg to the writer without blocking. A full buffer means the client
+// is not reading, and it is disconnected rather than buffered for without bound.
+func (w *watcher) enqueue(msg WatchMessage) bool {
+	select {
+	case w.send <- msg:
+		return true
+	default:
+		return false
+	}
+}
+
+// readCommands decodes client commands until the connection fails, allowing a
+// small burst of commands and then 5 a second.
+func (w *watcher) readCommands() {
+	defer close(w.done)
+
+	w.conn.SetReadLimit(watchMaxMessageSize)
+	_ = w.conn.SetReadDeadline(time.Now().Add(watchPongWait))
+	w.conn.SetPongHandler(func(string) error {
+		return w.conn.SetReadDeadline(time.Now().Add(watchPongWait))
+	})
+
+	limiter := rate.NewLimiter(5, 10)
+	for {
+		var request watchRequest
+		if err := w.conn.ReadJSON(&request.command); err != nil {
+			var syntaxErr *json.SyntaxError
+			var typeErr *json.UnmarshalTypeError
+			if !errors.As(err, &syntaxErr) && !errors.As(err, &typeErr) {
+				return
+			}
+			request.err = "commands must be JSON objects with action and item_ids"
+		}
+		if !limiter.Allow() {
+			request = watchRequest{err: "too many commands, slow down"}
+		}
+
+		select {
+		case w.commands <- request:
+		case <-w.stop:
+			return
+		}
+	}
+}
+
+// writeMessages sends queued messages and pings until a write fails or the
+// connection is closed.
+func (w *watcher) writeMessages() {
+	ping := time.NewTicker(watchPingPeriod)
+	defer ping.Stop()
+
+	for {
+		select {
+		case msg := <-w.send:
+			_ = w.conn.SetWriteDeadline(time.Now().Add(watchWriteWait))
+			if err := w.conn.WriteJSON(msg); err != nil {
+				return
+			}
+		case <-ping.C:
+			if err := w.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(watchWriteWait)); err != nil {
+				return
+			}
+		case <-w.stop:
+			return
+		}
+	}
+}
+
+func (w *watcher) itemIDs() []string {
+	ids := make([]string, 0, len(w.items))
+	for id := range w.items {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// snapshotMessage copies the item's values as of position, which later events
+// update.
+func snapshotMessage(item *models.Item, position int64) WatchMessage {
+	stock, available, price := item.Stock, item.Stock-item.Reserved, item.Price
+	return WatchMessage{
+		Type:      watchSnapshot,
+		ItemID:    item.ID,
+		Position:  position,
+		Stock:     &stock,
+		Available: &available,
+		Price:     &price,
+		Currency:  item.Currency,
+	}
+}
@@ -0,0 +1,245 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"inventory-service/src/middlewares"
+	"inventory-service/src/models"
+	"inventory-service/src/services"
+	"inventory-service/src/utils"
+)
+
+// WebhookRequest defines the payload to create or replace a webhook subscription.
+type WebhookRequest struct {
+	URL     string   `json:"url" binding:"required,url" example:"https://partner.example.com/hooks/inventory"`
+	Secret  string   `json:"secret" binding:"omitempty,min=16,max=255" example:"whsec_3f9a1c7e5b2d4f608a1e"`
+	Events  []string `json:"events" binding:"required,min=1" example:"item.created,item.updated,item.deleted"`
+	Enabled *bool    `json:"enabled" example:"true"`
+}
+
+// WebhookSecretResponse is a subscription together with its signing secret. The
+// secret is only included when the request set or generated it.
+type WebhookSecretResponse struct {
+	models.WebhookSubscription
+	Secret string `json:"secret,omitempty" example:"whsec_3f9a1c7e5b2d4f608a1e"`
+}
+
+// GetWebhooks handles GET /webhooks requests and returns all webhook subscriptions.
+// @Summary List webhook subscriptions
+// @Description Retrieve the subscriptions domain events are POSTed to. Secrets are not included.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.WebhookSubscription
+// @Failure 500 {object} map[string]string
+// @Router /webhooks [get]
+func GetWebhooks(c *gin.Context) {
+	var subscriptions []models.WebhookSubscription
+	db := utils.ConnectDatabase()
+	if err := db.Order("created_at asc").Find(&subscriptions).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, subscriptions)
+}
+
+// GetWebhookByID handles GET /webhooks/:id requests and returns the matching subscription.
+// @Summary Get a webhook subscription
+// @Description Retrieve a single subscription by its identifier. Its secret is not included.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Success 200 {object} models.WebhookSubscription
+// @Failure 404 {object} map[string]string
+// @Router /webhooks/{id} [get]
+func GetWebhookByID(c *gin.Context) {
+	var subscription models.WebhookSubscription
+	db := utils.ConnectDatabase()
+	if err := db.First(&subscription, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "webhook subscription not found"})
+		return
+	}
+	c.JSON(http.StatusOK, subscription)
+}
+
+// CreateWebhook handles POST /webhooks requests to subscribe a URL to domain events.
+// @Summary Create a webhook subscription
+// @Description POST the given events (item.created, item.updated, item.deleted, stock.changed, stock.reserved, alert.low_stock, alert.out_of_stock, alert.recovered) to url. Each delivery is signed in X-Inventory-Signature with sha256=<hex HMAC-SHA256 of "<X-Inventory-Timestamp>.<body>">, keyed with the secret.
+// @Description Without a secret one is generated; either way it is only returned in this response, and not in the replay of a request retried with the same Idempotency-Key. Failed deliveries are retried with exponential backoff and dead-lettered after the last attempt.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param webhook body WebhookRequest true "Subscription to create"
+// @Success 201 {object} WebhookSecretResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /webhooks [post]
+func CreateWebhook(c *gin.Context) {
+	var input WebhookRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var subscription models.WebhookSubscription
+	saveWebhook(c, &subscription, input, http.StatusCreated)
+}
+
+// UpdateWebhook handles PUT /webhooks/:id requests to replace a subscription.
+// @Summary Replace a webhook subscription
+// @Description Replace a subscription's URL, events and enabled flag. A secret rotates the signing secret and is echoed in the response, but not in the replay of a request retried with the same Idempotency-Key; without one the current secret is kept. Deliveries already queued are sent to the new URL.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Param webhook body WebhookRequest true "New subscription"
+// @Success 200 {object} WebhookSecretResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /webhooks/{id} [put]
+func UpdateWebhook(c *gin.Context) {
+	var subscription models.WebhookSubscription
+	db := utils.ConnectDatabase()
+	if err := db.First(&subscription, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "webhook subscription not found"})
+		return
+	}
+
+	var input WebhookRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	saveWebhook(c, &subscription, input, http.StatusOK)
+}
+
+// DeleteWebhook handles DELETE /webhooks/:id requests to remove a subscription.
+// @Summary Delete a webhook subscription
+// @Description Remove a subscription together with its queued deliveries and delivery log.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Success 204 {string} string "No Content"
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /webhooks/{id} [delete]
+func DeleteWebhook(c *gin.Context) {
+	db := utils.ConnectDatabase()
+	result := db.Delete(&models.WebhookSubscription{}, "id = ?", c.Param("id"))
+	if result.Error != nil {
+		respondError(c, result.Error)
+		return
+	}
+	if result.RowsAffected == 0 {
+		respondError(c, services.ErrWebhookNotFound)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetWebhookDeliveries handles GET /webhooks/:id/deliveries requests and returns the delivery log.
+// @Summary List webhook deliveries
+// @Description Retrieve a subscription's deliveries, newest first, with their attempts, last response status and error.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Param status query string false "Filter by status (pending|delivered|dead_letter)"
+// @Param event_id query string false "Filter by event ID"
+// @Param limit query int false "Deliveries per page (default 10, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {array} models.WebhookDelivery
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /webhooks/{id}/deliveries [get]
+func GetWebhookDeliveries(c *gin.Context) {
+	var subscription models.WebhookSubscription
+	db := utils.ConnectDatabase()
+	if err := db.First(&subscription, "id = ?", c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "webhook subscription not found"})
+		return
+	}
+
+	query := db.Model(&models.WebhookDelivery{}).Where("subscription_id = ?", subscription.ID)
+	if status := c.Query("status"); status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if eventID := c.Query("event_id"); eventID != "" {
+		query = query.Where("event_id = ?", eventID)
+	}
+
+	limit, offset := paginate(c)
+
+	var deliveries []models.WebhookDelivery
+	if err := query.Order("created_at desc").Limit(limit).Offset(offset).Find(&deliveries).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, deliveries)
+}
+
+// RedeliverWebhook handles POST /webhooks/:id/deliveries/:delivery_id/redeliver requests.
+// @Summary Redeliver a webhook event
+// @Description Queue a fresh delivery of the same event and payload, for example after a dead-lettered delivery's receiver is fixed. The original delivery stays in the log; receivers can deduplicate on the event id.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Param delivery_id path string true "Delivery ID"
+// @Success 202 {object} models.WebhookDelivery
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /webhooks/{id}/deliveries/{delivery_id}/redeliver [post]
+func RedeliverWebhook(c *gin.Context) {
+	var delivery *models.WebhookDelivery
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		delivery, err = services.RedeliverWebhook(tx, c.Param("id"), c.Param("delivery_id"))
+		return err
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, delivery)
+}
+
+func saveWebhook(c *gin.Context, subscription *models.WebhookSubscription, input WebhookRequest, status int) {
+	subscription.URL = input.URL
+	subscription.Events = input.Events
+	subscription.Enabled = input.Enabled == nil || *input.Enabled
+	if input.Secret != "" {
+		subscription.Secret = input.Secret
+	}
+	// A new secret is returned once so the subscriber can verify signatures
+	reveal := input.Secret != "" || subscription.ID == ""
+
+	db := utils.ConnectDatabase()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return services.SaveWebhookSubscription(tx, subscription)
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := WebhookSecretResponse{WebhookSubscription: *subscription}
+	if reveal {
+		response.Secret = subscription.Secret
+		// A retry with the same Idempotency-Key gets the subscription without it
+		middlewares.ReplayWith(c, subscription)
+	}
+	c.JSON(status, response)
+}
@@ -0,0 +1,44 @@
+package events
+
+import (
+	"encoding/json"
+	"strings"
+
+	"inventory-service/src/models"
+)
+
+// Filter selects the events a subscriber is interested in. The zero value matches
+// every event.
+type Filter struct {
+	// ItemIDs limits events to these items.
+	ItemIDs []string
+	// Name limits events to items whose name contains it, ignoring case.
+	Name string
+}
+
+// Match reports whether event passes the filter.
+func (f Filter) Match(event models.OutboxEvent) bool {
+	if len(f.ItemIDs) > 0 {
+		found := false
+		for _, id := range f.ItemIDs {
+			if id == event.AggregateID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.Name != "" {
+		// Item events carry the item and stock.changed carries its name
+		var data struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(event.Data, &data); err != nil {
+			return false
+		}
+		return strings.Contains(strings.ToLower(data.Name), strings.ToLower(f.Name))
+	}
+	return true
+}
@@ -0,0 +1,123 @@
+// Package events fans domain events out to live subscribers on this replica. The
+// outbox relay publishes every event to a Redis pub/sub channel; each replica runs
+// one listener on that channel and hands events to its local subscribers.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+
+	"inventory-service/src/models"
+)
+
+// subscriptionBuffer is how many events a subscriber may fall behind by before it
+// is dropped.
+const subscriptionBuffer = 256
+
+// Subscription receives live events on C. C is closed when the subscription is
+// closed or when the subscriber fell too far behind, in which case Overflowed
+// reports true and the subscriber should resume from the outbox.
+type Subscription struct {
+	C <-chan models.OutboxEvent
+
+	events     chan models.OutboxEvent
+	overflowed bool
+}
+
+// Overflowed reports whether the subscription was dropped for falling behind.
+func (sub *Subscription) Overflowed() bool {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	return sub.overflowed
+}
+
+// Close stops the subscription. It is safe to call more than once.
+func (sub *Subscription) Close() {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	if _, ok := hub.subscribers[sub]; ok {
+		delete(hub.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+var hub = struct {
+	mu          sync.Mutex
+	subscribers map[*Subscription]struct{}
+	closed      bool
+}{subscribers: map[*Subscription]struct{}{}}
+
+// Subscribe starts receiving every event published from now on. After Shutdown
+// the subscription is returned already closed.
+func Subscribe() *Subscription {
+	events := make(chan models.OutboxEvent, subscriptionBuffer)
+	sub := &Subscription{C: events, events: events}
+
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	if hub.closed {
+		close(events)
+		return sub
+	}
+	hub.subscribers[sub] = struct{}{}
+	return sub
+}
+
+// Shutdown closes every subscription so long-lived streams end and the server can
+// stop.
+func Shutdown() {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	hub.closed = true
+	for sub := range hub.subscribers {
+		delete(hub.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+// Broadcast hands event to every subscriber without blocking. Subscribers whose
+// buffer is full are dropped rather than slowing everyone else down.
+func Broadcast(event models.OutboxEvent) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	for sub := range hub.subscribers {
+		select {
+		case sub.events <- event:
+		default:
+			sub.overflowed = true
+			delete(hub.subscribers, sub)
+			close(sub.events)
+		}
+	}
+}
+
+// Listen broadcasts the events published on channel until ctx is cancelled. The
+// Redis client reconnects and resubscribes by itself after connection errors.
+func Listen(ctx context.Context, client *redis.Client, channel string) {
+	pubsub := client.Subscribe(ctx, channel)
+	go func() {
+		defer pubsub.Close()
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message, ok := <-messages:
+				if !ok {
+					return
+				}
+				var event models.OutboxEvent
+				if err := json.Unmarshal([]byte(message.Payload), &event); err != nil {
+					log.Printf("events: %v", err)
+					continue
+				}
+				Broadcast(event)
+			}
+		}
+	}()
+}
@@ -0,0 +1,75 @@
+package grpcserver
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"inventory-service/src/models"
+	inventoryv1 "inventory-service/src/pb/inventory/v1"
+)
+
+func itemMessage(item *models.Item) (*inventoryv1.Item, error) {
+	attributes, err := structpb.NewStruct(item.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &inventoryv1.Item{
+		Id:                item.ID,
+		Sku:               item.SKU,
+		Barcode:           item.Barcode,
+		Name:              item.Name,
+		CategoryId:        item.CategoryID,
+		Tags:              item.Tags,
+		Attributes:        attributes,
+		AttributeSchemaId: item.AttributeSchemaID,
+		Stock:             int32(item.Stock),
+		Reserved:          int32(item.Reserved),
+		InTransit:         int32(item.InTransit),
+		Quarantined:       int32(item.Quarantined),
+		OnHand:            int32(item.OnHand),
+		Available:         int32(item.Available),
+		ReorderPoint:      int32(item.ReorderPoint),
+		ReorderQuantity:   int32(item.ReorderQuantity),
+		SafetyStock:       int32(item.SafetyStock),
+		Price:             item.Price.String(),
+		Currency:          item.Currency,
+		Version:           int32(item.Version),
+		CreatedAt:         timestamppb.New(item.CreatedAt),
+		UpdatedAt:         timestamppb.New(item.UpdatedAt),
+	}
+	for _, stock := range item.Locations {
+		location := &inventoryv1.ItemStock{LocationId: stock.LocationID, Quantity: int32(stock.Quantity)}
+		if stock.Location != nil {
+			location.LocationCode, location.LocationName = stock.Location.Code, stock.Location.Name
+		}
+		msg.Locations = append(msg.Locations, location)
+	}
+	return msg, nil
+}
+
+func eventMessage(event models.OutboxEvent) (*inventoryv1.ItemEvent, error) {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(event.Data, &fields); err != nil {
+		return nil, err
+	}
+	data, err := structpb.NewStruct(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inventoryv1.ItemEvent{
+		Sequence:    event.Sequence,
+		Position:    *event.Position,
+		Id:          event.ID,
+		Type:        event.Type,
+		ItemId:      event.AggregateID,
+		ItemVersion: int32(event.AggregateVersion),
+		Actor:       event.Actor,
+		RequestId:   event.RequestID,
+		Data:        data,
+		OccurredAt:  timestamppb.New(event.OccurredAt),
+	}, nil
+}
@@ -0,0 +1,54 @@
+package grpcserver
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"inventory-service/src/middlewares"
+)
+
+// rateLimiter limits each caller, identified like middlewares.UserIdentity by
+// x-user-id metadata or else by address. Server reflection is exempt, like the
+// REST API's Swagger UI.
+type rateLimiter struct {
+	requestsPerSecond int
+	burst             int
+}
+
+func (l rateLimiter) unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := l.allow(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// stream counts a stream as one request when it opens.
+func (l rateLimiter) stream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := l.allow(stream.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
+
+func (l rateLimiter) allow(ctx context.Context, method string) error {
+	if strings.HasPrefix(method, "/grpc.reflection.") {
+		return nil
+	}
+	identity := callerID(ctx)
+	if identity == "" {
+		identity = clientIP(ctx)
+	}
+
+	result, err := middlewares.AllowUser(ctx, identity, l.requestsPerSecond, l.burst)
+	if err != nil {
+		return status.Error(codes.Internal, "rate limiter error")
+	}
+	if result.Allowed == 0 {
+		return status.Errorf(codes.ResourceExhausted, "too many requests, retry after %s", result.RetryAfter)
+	}
+	return nil
+}
@@ -0,0 +1,372 @@
+// Package grpcserver serves the InventoryService gRPC API. Its handlers translate
+// messages and call the same services as the REST controllers, so both APIs share
+// validation, events, audit entries and the stock ledger.
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+	inventoryv1 "inventory-service/src/pb/inventory/v1"
+	"inventory-service/src/services"
+	"inventory-service/src/utils"
+)
+
+var (
+	errVersionMismatch = errors.New("item has been modified since it was read")
+	errVersionRequired = errors.New("expected_version is required")
+)
+
+// Currency codes are checked like the REST API's iso4217 binding
+var validate = validator.New()
+
+// Server implements inventoryv1.InventoryServiceServer.
+type Server struct {
+	inventoryv1.UnimplementedInventoryServiceServer
+}
+
+// New returns a gRPC server with InventoryService and server reflection registered,
+// so tools such as grpcurl work without the proto files. Each caller may make
+// requestsPerSecond calls a second with bursts of burst.
+//
+// Idempotency-Key replay is REST-only. Retried writes are made safe instead by
+// expected_version, which fails a repeated UpdateItem or DeleteItem with
+// FAILED_PRECONDITION, and by SKUs being unique, which fails a repeated CreateItem
+// with ALREADY_EXISTS.
+func New(requestsPerSecond, burst int) *grpc.Server {
+	limiter := rateLimiter{requestsPerSecond: requestsPerSecond, burst: burst}
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(limiter.unary),
+		grpc.ChainStreamInterceptor(limiter.stream),
+	)
+	inventoryv1.RegisterInventoryServiceServer(server, &Server{})
+	reflection.Register(server)
+	return server
+}
+
+// GetItems lists items like GET /inventory.
+func (s *Server) GetItems(ctx context.Context, req *inventoryv1.GetItemsRequest) (*inventoryv1.GetItemsResponse, error) {
+	filter := services.ItemFilter{
+		Name:               req.GetName(),
+		Currency:           req.GetCurrency(),
+		CategoryID:         req.GetCategoryId(),
+		IncludeDescendants: req.GetIncludeDescendants(),
+		Tags:               req.GetTags(),
+		Attributes:         req.GetAttributes(),
+		Location:           req.GetLocation(),
+		SortBy:             req.GetSortBy(),
+		Order:              req.GetOrder(),
+	}
+	if req.AsOf != nil {
+		asOf := req.AsOf.AsTime()
+		filter.AsOf = &asOf
+	}
+	if req.MinStock != nil {
+		minStock := int(req.GetMinStock())
+		filter.MinStock = &minStock
+	}
+
+	db := utils.ConnectDatabase().WithContext(ctx)
+	query, err := services.ItemQuery(db, filter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit, offset := int(req.GetLimit()), int(req.GetOffset())
+	if limit < 1 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	var items []models.Item
+	if err := query.Limit(limit).Offset(offset).Find(&items).Error; err != nil {
+		return nil, statusError(err)
+	}
+
+	resp := &inventoryv1.GetItemsResponse{Items: make([]*inventoryv1.Item, 0, len(items))}
+	for i := range items {
+		msg, err := itemMessage(&items[i])
+		if err != nil {
+			return nil, statusError(err)
+		}
+		resp.Items = append(resp.Items, msg)
+	}
+	return resp, nil
+}
+
+// GetItemByID returns an item like GET /inventory/:id.
+func (s *Server) GetItemByID(ctx context.Context, req *inventoryv1.GetItemByIDRequest) (*inventoryv1.Item, error) {
+	if err := uuid.Validate(req.GetId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id must be a UUID")
+	}
+	var asOf *time.Time
+	if req.AsOf != nil {
+		at := req.AsOf.AsTime()
+		asOf = &at
+	}
+
+	item, err := services.FindItem(utils.ConnectDatabase().WithContext(ctx), req.GetId(), asOf)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return respondItem(item)
+}
+
+// CreateItem adds an item like POST /inventory.
+func (s *Server) CreateItem(ctx context.Context, req *inventoryv1.CreateItemRequest) (*inventoryv1.Item, error) {
+	if strings.TrimSpace(req.GetName()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	price, err := parsePrice(req.GetPrice())
+	if err != nil {
+		return nil, err
+	}
+	currency := req.GetCurrency()
+	if currency == "" {
+		currency = models.DefaultCurrency
+	}
+	if err := validateCurrency(currency); err != nil {
+		return nil, err
+	}
+
+	item := models.Item{
+		SKU:               req.GetSku(),
+		Barcode:           req.Barcode,
+		Name:              req.GetName(),
+		CategoryID:        req.CategoryId,
+		Tags:              req.GetTags(),
+		Attributes:        req.GetAttributes().AsMap(),
+		AttributeSchemaID: req.AttributeSchemaId,
+		Price:             price,
+		Currency:          currency,
+		ReorderPoint:      int(req.GetReorderPoint()),
+		ReorderQuantity:   int(req.GetReorderQuantity()),
+		SafetyStock:       int(req.GetSafetyStock()),
+	}
+	if err := services.ValidateItem(&item); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	audit := auditContext(ctx)
+	db := utils.ConnectDatabase().WithContext(ctx)
+	err = db.Transaction(func(tx *gorm.DB) error {
+		return services.CreateItem(tx, &item, int(req.GetStock()), audit)
+	})
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return respondItem(&item)
+}
+
+// UpdateItem changes the fields that are set like PUT /inventory/:id, with
+// expected_version in place of If-Match.
+func (s *Server) UpdateItem(ctx context.Context, req *inventoryv1.UpdateItemRequest) (*inventoryv1.Item, error) {
+	if err := uuid.Validate(req.GetId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id must be a UUID")
+	}
+
+	patch := services.ItemPatch{
+		SKU:             req.Sku,
+		Barcode:         req.Barcode,
+		Name:            req.Name,
+		CategoryID:      req.CategoryId,
+		SchemaID:        req.AttributeSchemaId,
+		Currency:        req.Currency,
+		Stock:           intPointer(req.Stock),
+		ReorderPoint:    intPointer(req.ReorderPoint),
+		ReorderQuantity: intPointer(req.ReorderQuantity),
+		SafetyStock:     intPointer(req.SafetyStock),
+	}
+	if req.Tags != nil {
+		tags := append([]string{}, req.Tags.GetValues()...)
+		patch.Tags = &tags
+	}
+	if req.Attributes != nil {
+		patch.Attributes = req.Attributes.AsMap()
+	}
+	if req.Price != nil {
+		price, err := parsePrice(req.GetPrice())
+		if err != nil {
+			return nil, err
+		}
+		patch.Price = &price
+	}
+	if req.Currency != nil {
+		if err := validateCurrency(req.GetCurrency()); err != nil {
+			return nil, err
+		}
+	}
+
+	var item *models.Item
+	audit := auditContext(ctx)
+	db := utils.ConnectDatabase().WithContext(ctx)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		item, err = services.UpdateItem(tx, req.GetId(), patch, audit, checkVersion(req.ExpectedVersion))
+		return err
+	})
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return respondItem(item)
+}
+
+// DeleteItem moves an item to the trash like DELETE /inventory/:id, with
+// expected_version in place of If-Match.
+func (s *Server) DeleteItem(ctx context.Context, req *inventoryv1.DeleteItemRequest) (*inventoryv1.DeleteItemResponse, error) {
+	if err := uuid.Validate(req.GetId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id must be a UUID")
+	}
+
+	audit := auditContext(ctx)
+	db := utils.ConnectDatabase().WithContext(ctx)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return services.DeleteItem(tx, req.GetId(), audit, checkVersion(req.ExpectedVersion))
+	})
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &inventoryv1.DeleteItemResponse{}, nil
+}
+
+// checkVersion is the gRPC counterpart of If-Match: the write only applies while the
+// item is at the expected version. REQUIRE_IF_MATCH=true makes it mandatory here too.
+func checkVersion(expected *int32) func(models.Item) error {
+	return func(item models.Item) error {
+		if expected == nil {
+			if os.Getenv("REQUIRE_IF_MATCH") == "true" {
+				return errVersionRequired
+			}
+			return nil
+		}
+		if int(*expected) != item.Version {
+			return errVersionMismatch
+		}
+		return nil
+	}
+}
+
+// auditContext identifies the caller from the x-user-id and x-request-id metadata,
+// which carry the same values as the REST API's X-User-ID and X-Request-ID headers.
+// The request ID, generated when missing, is sent back as response metadata.
+func auditContext(ctx context.Context) services.AuditContext {
+	audit := services.AuditContext{Actor: "anonymous"}
+	if userID := callerID(ctx); userID != "" {
+		audit.Actor = userID
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("x-request-id"); len(values) > 0 && values[0] != "" && len(values[0]) <= 64 {
+		audit.RequestID = values[0]
+	} else {
+		audit.RequestID = uuid.NewString()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-request-id", audit.RequestID))
+
+	audit.ClientIP = clientIP(ctx)
+	return audit
+}
+
+// callerID is the x-user-id metadata, or empty for anonymous callers.
+func callerID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("x-user-id"); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+// clientIP is the caller's address without its port.
+func clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	addr := p.Addr.String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// statusError maps a service error onto a gRPC status through the HTTP status the
+// REST API answers it with.
+func statusError(err error) error {
+	if s, ok := status.FromError(err); ok {
+		return s.Err()
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	if errors.Is(err, errVersionMismatch) || errors.Is(err, errVersionRequired) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	// Conflicts on unique identifiers are the one kind of 409 a retry cannot fix by
+	// changing the item's state first
+	if errors.Is(err, services.ErrIdentifierInUse) || errors.Is(err, gorm.ErrDuplicatedKey) {
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	code := codes.Internal
+	switch services.ErrorStatus(err) {
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	case http.StatusNotFound:
+		code = codes.NotFound
+	case http.StatusConflict, http.StatusPreconditionFailed, http.StatusPreconditionRequired:
+		code = codes.FailedPrecondition
+	}
+	return status.Error(code, err.Error())
+}
+
+func respondItem(item *models.Item) (*inventoryv1.Item, error) {
+	msg, err := itemMessage(item)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return msg, nil
+}
+
+func parsePrice(value string) (decimal.Decimal, error) {
+	price, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Decimal{}, status.Error(codes.InvalidArgument, "price must be a decimal number")
+	}
+	return price, nil
+}
+
+func validateCurrency(currency string) error {
+	if err := validate.Var(currency, "iso4217"); err != nil {
+		return status.Error(codes.InvalidArgument, "currency must be an ISO 4217 code")
+	}
+	return nil
+}
+
+func intPointer(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+	converted := int(*value)
+	return &converted
+}
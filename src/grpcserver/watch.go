@@ -0,0 +1,66 @@
+package grpcserver
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"inventory-service/src/events"
+	"inventory-service/src/models"
+	inventoryv1 "inventory-service/src/pb/inventory/v1"
+	"inventory-service/src/services"
+	"inventory-service/src/utils"
+)
+
+// WatchItems streams events like GET /inventory/stream. The stream ends with
+// Unavailable when the server shuts down or the caller falls too far behind; either
+// way the caller reconnects with the last position it received as after_position.
+func (s *Server) WatchItems(req *inventoryv1.WatchItemsRequest, stream grpc.ServerStreamingServer[inventoryv1.ItemEvent]) error {
+	filter := events.Filter{ItemIDs: req.GetItemIds(), Name: req.GetName()}
+	if req.GetAfterPosition() < 0 {
+		return status.Error(codes.InvalidArgument, "after_position must be an event position")
+	}
+
+	sub := events.Subscribe()
+	defer sub.Close()
+
+	var sent services.Watermark
+	if req.AfterPosition != nil {
+		var err error
+		db := utils.ConnectDatabase().WithContext(stream.Context())
+		sent, err = services.ReplayAfter(db, req.GetAfterPosition(), filter, func(event models.OutboxEvent) error {
+			return sendEvent(stream, event)
+		})
+		if err != nil {
+			return statusError(err)
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case event, ok := <-sub.C:
+			if !ok {
+				if sub.Overflowed() {
+					return status.Error(codes.Unavailable, "fell behind, resume with after_position")
+				}
+				return status.Error(codes.Unavailable, "server shutting down")
+			}
+			if sent.Seen(event) || !filter.Match(event) {
+				continue
+			}
+			if err := sendEvent(stream, event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func sendEvent(stream grpc.ServerStreamingServer[inventoryv1.ItemEvent], event models.OutboxEvent) error {
+	msg, err := eventMessage(event)
+	if err != nil {
+		return statusError(err)
+	}
+	return stream.Send(msg)
+}
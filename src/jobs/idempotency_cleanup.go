@@ -0,0 +1,35 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+)
+
+// StartIdempotencyCleanup periodically deletes expired idempotency keys from the
+// Postgres fallback store until ctx is cancelled. Redis expires its own keys.
+func StartIdempotencyCleanup(ctx context.Context, db *gorm.DB, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				result := db.Where("expires_at < ?", time.Now()).Delete(&models.IdempotencyKey{})
+				if result.Error != nil {
+					log.Printf("idempotency cleanup: %v", result.Error)
+				}
+				if result.RowsAffected > 0 {
+					log.Printf("idempotency cleanup: removed %d expired keys", result.RowsAffected)
+				}
+			}
+		}
+	}()
+}
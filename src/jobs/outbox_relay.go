@@ -0,0 +1,49 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	"inventory-service/src/services"
+)
+
+// StartOutboxRelay periodically publishes outbox events to the Redis event stream
+// and deletes events published more than retention ago, until ctx is cancelled.
+func StartOutboxRelay(ctx context.Context, db *gorm.DB, client *redis.Client, interval, retention time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		pruneTicker := time.NewTicker(time.Hour)
+		defer pruneTicker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				// Drain the backlog a batch at a time
+				for {
+					published, err := services.RelayOutbox(ctx, db, client)
+					if err != nil {
+						log.Printf("outbox relay: %v", err)
+					}
+					if err != nil || published == 0 {
+						break
+					}
+				}
+			case <-pruneTicker.C:
+				pruned, err := services.PrunePublishedEvents(db, time.Now().Add(-retention))
+				if err != nil {
+					log.Printf("outbox relay: %v", err)
+				}
+				if pruned > 0 {
+					log.Printf("outbox relay: removed %d published events", pruned)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,35 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+
+	"inventory-service/src/services"
+)
+
+// StartReplenishment periodically drafts purchase orders for items at or below
+// their reorder point until ctx is cancelled.
+func StartReplenishment(ctx context.Context, db *gorm.DB, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				drafted, err := services.DraftReplenishmentOrders(db)
+				if err != nil {
+					log.Printf("replenishment: %v", err)
+				}
+				if drafted > 0 {
+					log.Printf("replenishment: drafted %d purchase orders", drafted)
+				}
+			}
+		}
+	}()
+}
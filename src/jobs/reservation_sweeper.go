@@ -0,0 +1,35 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+
+	"inventory-service/src/services"
+)
+
+// StartReservationSweeper periodically returns expired reservations to available
+// stock until ctx is cancelled.
+func StartReservationSweeper(ctx context.Context, db *gorm.DB, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				expired, err := services.ExpireReservations(db)
+				if err != nil {
+					log.Printf("reservation sweeper: %v", err)
+				}
+				if expired > 0 {
+					log.Printf("reservation sweeper: released %d expired reservations", expired)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,35 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+
+	"inventory-service/src/services"
+)
+
+// StartTrashRetention periodically purges items that have been soft-deleted for
+// longer than retention until ctx is cancelled.
+func StartTrashRetention(ctx context.Context, db *gorm.DB, retention, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				purged, err := services.PurgeDeletedBefore(db, time.Now().Add(-retention))
+				if err != nil {
+					log.Printf("trash retention: %v", err)
+				}
+				if purged > 0 {
+					log.Printf("trash retention: purged %d deleted items", purged)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,31 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+
+	"inventory-service/src/services"
+)
+
+// StartWebhookDelivery periodically attempts due webhook deliveries until ctx is
+// cancelled.
+func StartWebhookDelivery(ctx context.Context, db *gorm.DB, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := services.DeliverWebhooks(ctx, db); err != nil {
+					log.Printf("webhook delivery: %v", err)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,202 @@
+// Package labels renders printable shelf labels carrying a Code128 or QR symbol
+// and a few lines of text, as PNG, SVG or PDF, without external services.
+package labels
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/qr"
+)
+
+// Supported symbologies
+const (
+	Code128 = "code128"
+	QR      = "qr"
+)
+
+// Supported output formats
+const (
+	PNG = "png"
+	SVG = "svg"
+	PDF = "pdf"
+)
+
+var ErrUnsupported = errors.New("unsupported label option")
+
+// Label is the content of a single label: the value encoded in the symbol and
+// the lines of text printed beneath it.
+type Label struct {
+	Content string
+	Lines   []string
+}
+
+// ContentType returns the MIME type of a format.
+func ContentType(format string) string {
+	switch format {
+	case SVG:
+		return "image/svg+xml"
+	case PDF:
+		return "application/pdf"
+	default:
+		return "image/png"
+	}
+}
+
+// Render writes labels in the given symbology and format. PNG and SVG lay the
+// labels out on a grid three columns wide; PDF fills A4 sheets of 24 labels.
+func Render(w io.Writer, labels []Label, symbology, format string) error {
+	if symbology != Code128 && symbology != QR {
+		return fmt.Errorf("%w: symbology must be %s or %s", ErrUnsupported, Code128, QR)
+	}
+
+	symbols := make([]symbol, len(labels))
+	for i, label := range labels {
+		s, err := encode(label.Content, symbology)
+		if err != nil {
+			return err
+		}
+		symbols[i] = s
+	}
+
+	switch format {
+	case PNG:
+		return renderPNG(w, labels, symbols)
+	case SVG:
+		return renderSVG(w, labels, symbols)
+	case PDF:
+		return renderPDF(w, labels, symbols)
+	default:
+		return fmt.Errorf("%w: format must be %s, %s or %s", ErrUnsupported, PNG, SVG, PDF)
+	}
+}
+
+// symbol is an encoded barcode as a grid of modules. Linear symbols are one row
+// high and are stretched vertically when drawn.
+type symbol struct {
+	cols, rows int
+	linear     bool
+	dark       func(x, y int) bool
+}
+
+func encode(content, symbology string) (symbol, error) {
+	var (
+		code barcode.Barcode
+		err  error
+	)
+	if symbology == QR {
+		code, err = qr.Encode(content, qr.M, qr.Auto)
+	} else {
+		code, err = code128.Encode(content)
+	}
+	if err != nil {
+		return symbol{}, fmt.Errorf("%w: cannot encode %q: %v", ErrUnsupported, content, err)
+	}
+
+	bounds := code.Bounds()
+	return symbol{
+		cols:   bounds.Dx(),
+		rows:   bounds.Dy(),
+		linear: code.Metadata().Dimensions == 1,
+		dark: func(x, y int) bool {
+			r, _, _, _ := code.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			return r < 0x8000
+		},
+	}, nil
+}
+
+// quietZone is the blank margin, in modules, a scanner needs around a symbol.
+func (s symbol) quietZone() int {
+	if s.linear {
+		return 10
+	}
+	return 4
+}
+
+// runs calls fn for every horizontal run of dark modules in row y.
+func (s symbol) runs(y int, fn func(x, length int)) {
+	for x := 0; x < s.cols; {
+		if !s.dark(x, y) {
+			x++
+			continue
+		}
+		start := x
+		for x < s.cols && s.dark(x, y) {
+			x++
+		}
+		fn(start, x-start)
+	}
+}
+
+// Raster geometry shared by PNG and SVG, in pixels
+const (
+	cellWidth    = 480
+	cellPadding  = 12
+	symbolHeight = 120
+	lineHeight   = 20
+	gridColumns  = 3
+)
+
+// pixelLayout sizes a raster cell so every symbol in it fits with its quiet
+// zone at a whole number of pixels per module.
+type pixelLayout struct {
+	width, height int
+	scales        []int
+}
+
+func layoutPixels(labels []Label, symbols []symbol) pixelLayout {
+	layout := pixelLayout{width: cellWidth, scales: make([]int, len(symbols))}
+
+	maxLines := 0
+	for i, s := range symbols {
+		span := s.cols + 2*s.quietZone()
+		scale := (cellWidth - 2*cellPadding) / span
+		if !s.linear {
+			scale = symbolHeight / span
+		}
+		if scale < 1 {
+			scale = 1
+		}
+		layout.scales[i] = scale
+		if width := span*scale + 2*cellPadding; width > layout.width {
+			layout.width = width
+		}
+		if n := len(labels[i].Lines); n > maxLines {
+			maxLines = n
+		}
+	}
+
+	layout.height = 2*cellPadding + symbolHeight + maxLines*lineHeight
+	return layout
+}
+
+// symbolSize returns the drawn width and height of a symbol at scale.
+func symbolSize(s symbol, scale int) (int, int) {
+	if s.linear {
+		return s.cols * scale, symbolHeight
+	}
+	return s.cols * scale, s.rows * scale
+}
+
+func gridSize(n int) (cols, rows int) {
+	cols = gridColumns
+	if n < cols {
+		cols = n
+	}
+	if cols == 0 {
+		cols = 1
+	}
+	return cols, (n + cols - 1) / cols
+}
+
+// truncate shortens s to at most max characters.
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max-3]) + "..."
+}
@@ -0,0 +1,109 @@
+package labels
+
+import (
+	"io"
+
+	"github.com/go-pdf/fpdf"
+)
+
+// A4 sheet of 3 x 8 labels, 70 x 37 mm each, in millimetres
+const (
+	sheetColumns      = 3
+	sheetRows         = 8
+	sheetLeft         = 0.0
+	sheetTop          = 0.5
+	labelWidthMM      = 70.0
+	labelHeightMM     = 37.0
+	labelPaddingMM    = 2.5
+	symbolHeightMM    = 18.0
+	lineHeightMM      = 4.0
+	fontSizePt        = 8.0
+	minModuleMM       = 0.19
+	preferredModuleMM = 0.33
+)
+
+func renderPDF(w io.Writer, labels []Label, symbols []symbol) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(0, 0, 0)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.SetFont("Helvetica", "", fontSizePt)
+	pdf.SetFillColor(0, 0, 0)
+	pdf.SetDrawColor(204, 204, 204)
+	// Core fonts are Latin-1; translate so accented names still print
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+
+	perSheet := sheetColumns * sheetRows
+	for i, label := range labels {
+		if i%perSheet == 0 {
+			pdf.AddPage()
+		}
+		slot := i % perSheet
+		left := sheetLeft + float64(slot%sheetColumns)*labelWidthMM
+		top := sheetTop + float64(slot/sheetColumns)*labelHeightMM
+		pdf.Rect(left, top, labelWidthMM, labelHeightMM, "D")
+
+		available := labelWidthMM - 2*labelPaddingMM
+		s, module, err := fitPDF(label.Content, symbols[i])
+		if err != nil {
+			return err
+		}
+
+		width := float64(s.cols) * module
+		height := symbolHeightMM
+		if !s.linear {
+			height = float64(s.rows) * module
+		}
+		x0 := left + (labelWidthMM-width)/2
+		y0 := top + labelPaddingMM + (symbolHeightMM-height)/2
+		for y := 0; y < s.rows; y++ {
+			s.runs(y, func(x, length int) {
+				barHeight := module
+				if s.linear {
+					barHeight = height
+				}
+				pdf.Rect(x0+float64(x)*module, y0+float64(y)*module, float64(length)*module, barHeight, "F")
+			})
+		}
+
+		lineTop := top + labelPaddingMM + symbolHeightMM + 1
+		for _, line := range label.Lines {
+			// Shorten by whole characters before translating, which turns each
+			// non-ASCII character into a byte the runes no longer line up with
+			text := line
+			for len([]rune(text)) > 3 && pdf.GetStringWidth(translate(text)) > available {
+				text = truncate(text, len([]rune(text))-1)
+			}
+			pdf.SetXY(left+labelPaddingMM, lineTop)
+			pdf.CellFormat(available, lineHeightMM, translate(text), "", 0, "C", false, 0, "")
+			lineTop += lineHeightMM
+		}
+	}
+
+	if len(labels) == 0 {
+		pdf.AddPage()
+	}
+	return pdf.Output(w)
+}
+
+// fitPDF returns the symbol printed on a PDF label and its module width in mm.
+// Code128 that does not fit the label at minModuleMM, such as a backfilled
+// ITEM-<uuid> SKU, would spill onto its neighbours, so it is printed as QR instead.
+func fitPDF(content string, s symbol) (symbol, float64, error) {
+	available := labelWidthMM - 2*labelPaddingMM
+	if s.linear && float64(s.cols+2*s.quietZone())*minModuleMM > available {
+		var err error
+		if s, err = encode(content, QR); err != nil {
+			return symbol{}, 0, err
+		}
+	}
+
+	span := float64(s.cols + 2*s.quietZone())
+	if !s.linear {
+		return s, symbolHeightMM / span, nil
+	}
+	module := preferredModuleMM
+	if span*module > available {
+		module = available / span
+	}
+	return s, module, nil
+}
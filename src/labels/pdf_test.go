@@ -0,0 +1,56 @@
+package labels
+
+import (
+	"bytes"
+	"testing"
+)
+
+// A SKU as BackfillSKUs derives it from an item ID
+const backfilledSKU = "ITEM-5D3C0A2E-7B1F-4E8A-9C6D-1F2E3A4B5C6D"
+
+func TestFitPDF(t *testing.T) {
+	available := labelWidthMM - 2*labelPaddingMM
+	tests := []struct {
+		name    string
+		content string
+		linear  bool
+	}{
+		{"short SKU stays Code128", "LAPTOP-001", true},
+		{"backfilled SKU falls back to QR", backfilledSKU, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := encode(tt.content, Code128)
+			if err != nil {
+				t.Fatal(err)
+			}
+			s, module, err := fitPDF(tt.content, code)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s.linear != tt.linear {
+				t.Fatalf("linear = %v, want %v", s.linear, tt.linear)
+			}
+			if width := float64(s.cols+2*s.quietZone()) * module; width > available+1e-9 {
+				t.Errorf("symbol is %.1fmm wide, label has %.1fmm", width, available)
+			}
+			if s.linear && module < minModuleMM {
+				t.Errorf("module = %.3fmm, below the %.2fmm minimum", module, minModuleMM)
+			}
+			if !s.linear && float64(s.rows)*module > symbolHeightMM+1e-9 {
+				t.Errorf("symbol is %.1fmm high, label has %.1fmm", float64(s.rows)*module, symbolHeightMM)
+			}
+		})
+	}
+}
+
+func TestRenderPDFBackfilledSKU(t *testing.T) {
+	var buf bytes.Buffer
+	labels := []Label{{Content: backfilledSKU, Lines: []string{"Crème brûlée torch with an unusually long name", "EUR 19.99"}}}
+	if err := Render(&buf, labels, Code128, PDF); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), []byte("%PDF")) {
+		t.Errorf("output is not a PDF")
+	}
+}
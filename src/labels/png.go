@@ -0,0 +1,69 @@
+package labels
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+var borderColor = color.Gray{Y: 0xcc}
+
+func renderPNG(w io.Writer, labels []Label, symbols []symbol) error {
+	layout := layoutPixels(labels, symbols)
+	cols, rows := gridSize(len(labels))
+
+	canvas := image.NewGray(image.Rect(0, 0, cols*layout.width, rows*layout.height))
+	draw.Draw(canvas, canvas.Bounds(), image.White, image.Point{}, draw.Src)
+
+	face := basicfont.Face7x13
+	maxChars := (layout.width - 2*cellPadding) / face.Advance
+
+	for i, label := range labels {
+		left := (i % cols) * layout.width
+		top := (i / cols) * layout.height
+		drawBorder(canvas, image.Rect(left, top, left+layout.width, top+layout.height))
+
+		s, scale := symbols[i], layout.scales[i]
+		width, height := symbolSize(s, scale)
+		x0 := left + (layout.width-width)/2
+		y0 := top + cellPadding + (symbolHeight-height)/2
+		for y := 0; y < s.rows; y++ {
+			s.runs(y, func(x, length int) {
+				bar := image.Rect(x0+x*scale, y0+y*scale, x0+(x+length)*scale, y0+(y+1)*scale)
+				if s.linear {
+					bar.Max.Y = y0 + height
+				}
+				draw.Draw(canvas, bar, image.Black, image.Point{}, draw.Src)
+			})
+		}
+
+		drawer := font.Drawer{Dst: canvas, Src: image.Black, Face: face}
+		baseline := top + cellPadding + symbolHeight + lineHeight - 4
+		for _, line := range label.Lines {
+			line = truncate(line, maxChars)
+			textWidth := drawer.MeasureString(line).Ceil()
+			drawer.Dot = fixed.P(left+(layout.width-textWidth)/2, baseline)
+			drawer.DrawString(line)
+			baseline += lineHeight
+		}
+	}
+
+	return png.Encode(w, canvas)
+}
+
+func drawBorder(canvas *image.Gray, r image.Rectangle) {
+	for x := r.Min.X; x < r.Max.X; x++ {
+		canvas.Set(x, r.Min.Y, borderColor)
+		canvas.Set(x, r.Max.Y-1, borderColor)
+	}
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		canvas.Set(r.Min.X, y, borderColor)
+		canvas.Set(r.Max.X-1, y, borderColor)
+	}
+}
@@ -0,0 +1,61 @@
+package labels
+
+import (
+	"bufio"
+	"html"
+	"io"
+	"strconv"
+)
+
+func renderSVG(w io.Writer, labels []Label, symbols []symbol) error {
+	layout := layoutPixels(labels, symbols)
+	cols, rows := gridSize(len(labels))
+	width, height := cols*layout.width, rows*layout.height
+
+	out := bufio.NewWriter(w)
+	out.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	out.WriteString(`<svg xmlns="http://www.w3.org/2000/svg" width="` + strconv.Itoa(width) +
+		`" height="` + strconv.Itoa(height) + `" viewBox="0 0 ` + strconv.Itoa(width) + ` ` + strconv.Itoa(height) + `">` + "\n")
+	out.WriteString(`<rect width="100%" height="100%" fill="#fff"/>` + "\n")
+
+	// Roughly the character width of the monospace font at 14px
+	maxChars := (layout.width - 2*cellPadding) / 9
+
+	for i, label := range labels {
+		left := (i % cols) * layout.width
+		top := (i / cols) * layout.height
+		out.WriteString(`<rect x="` + strconv.Itoa(left) + `" y="` + strconv.Itoa(top) +
+			`" width="` + strconv.Itoa(layout.width) + `" height="` + strconv.Itoa(layout.height) +
+			`" fill="none" stroke="#ccc"/>` + "\n")
+
+		s, scale := symbols[i], layout.scales[i]
+		symbolWidth, symbolHeightPx := symbolSize(s, scale)
+		x0 := left + (layout.width-symbolWidth)/2
+		y0 := top + cellPadding + (symbolHeight-symbolHeightPx)/2
+
+		out.WriteString(`<g fill="#000" shape-rendering="crispEdges">`)
+		for y := 0; y < s.rows; y++ {
+			s.runs(y, func(x, length int) {
+				barHeight := scale
+				if s.linear {
+					barHeight = symbolHeightPx
+				}
+				out.WriteString(`<rect x="` + strconv.Itoa(x0+x*scale) + `" y="` + strconv.Itoa(y0+y*scale) +
+					`" width="` + strconv.Itoa(length*scale) + `" height="` + strconv.Itoa(barHeight) + `"/>`)
+			})
+		}
+		out.WriteString("</g>\n")
+
+		baseline := top + cellPadding + symbolHeight + lineHeight - 4
+		center := left + layout.width/2
+		for _, line := range label.Lines {
+			out.WriteString(`<text x="` + strconv.Itoa(center) + `" y="` + strconv.Itoa(baseline) +
+				`" font-family="monospace" font-size="14" text-anchor="middle">` +
+				html.EscapeString(truncate(line, maxChars)) + "</text>\n")
+			baseline += lineHeight
+		}
+	}
+
+	out.WriteString("</svg>\n")
+	return out.Flush()
+}
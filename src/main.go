@@ -8,9 +8,11 @@ package main
 import (
 	"context"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
@@ -21,10 +23,14 @@ import (
 	ginSwagger "github.com/swaggo/gin-swagger"
 
 	docs "inventory-service/docs"
+	"inventory-service/src/events"
+	"inventory-service/src/grpcserver"
+	"inventory-service/src/jobs"
 	"inventory-service/src/middlewares"
 	"inventory-service/src/models"
 	"inventory-service/src/routes"
 	"inventory-service/src/seeds"
+	"inventory-service/src/services"
 	"inventory-service/src/utils"
 )
 
@@ -34,7 +40,34 @@ func main() {
 
 	db := utils.ConnectDatabase()
 
-	if err := db.AutoMigrate(&models.Item{}); err != nil {
+	if err := db.AutoMigrate(
+		&models.Category{},
+		&models.AttributeSchema{},
+		&models.Item{},
+		&models.StockMovement{},
+		&models.Reservation{},
+		&models.Location{},
+		&models.ItemStock{},
+		&models.TransferOrder{},
+		&models.IdempotencyKey{},
+		&models.AuditEntry{},
+		&models.Supplier{},
+		&models.ItemSupplier{},
+		&models.PurchaseOrder{},
+		&models.PurchaseOrderLine{},
+		&models.SalesOrder{},
+		&models.SalesOrderLine{},
+		&models.ReturnAuthorization{},
+		&models.ReturnLine{},
+		&models.ReturnDisposition{},
+		&models.AlertRule{},
+		&models.AlertState{},
+		&models.Alert{},
+		&models.OutboxEvent{},
+		&models.WebhookSubscription{},
+		&models.WebhookDelivery{},
+		&models.ItemVersion{},
+	); err != nil {
 		log.Fatalf("failed to run migrations: %v", err)
 	}
 
@@ -42,8 +75,37 @@ func main() {
 		log.Fatalf("failed to seed database: %v", err)
 	}
 
+	if err := services.BackfillSKUs(db); err != nil {
+		log.Fatalf("failed to backfill item SKUs: %v", err)
+	}
+
+	if err := services.ReconcileLedger(db); err != nil {
+		log.Fatalf("failed to reconcile stock ledger: %v", err)
+	}
+
+	if err := services.ReconcileLocations(db); err != nil {
+		log.Fatalf("failed to reconcile location stock: %v", err)
+	}
+
+	if err := services.BackfillItemVersions(db); err != nil {
+		log.Fatalf("failed to backfill item versions: %v", err)
+	}
+
+	if err := services.BackfillAlertLevels(db); err != nil {
+		log.Fatalf("failed to backfill alert levels: %v", err)
+	}
+
+	if err := services.BackfillEventPositions(db); err != nil {
+		log.Fatalf("failed to backfill event positions: %v", err)
+	}
+
 	router := gin.New()
-	router.Use(cors.Default())
+	// Browsers must be allowed to send conditional headers and read ETags
+	corsConfig := cors.DefaultConfig()
+	corsConfig.AllowAllOrigins = true
+	corsConfig.AddAllowHeaders("If-Match", "If-None-Match", "X-User-ID", "X-Request-ID", "Idempotency-Key", "X-Admin-Token")
+	corsConfig.AddExposeHeaders("ETag", "Idempotent-Replayed", "X-Request-ID")
+	router.Use(cors.New(corsConfig))
 	middlewares.Register(router)
 
 	// Initialize Redis-based rate limiter
@@ -65,10 +127,46 @@ func main() {
 	// Apply Redis rate limiter globally (1 req/sec, burst 5)
 	router.Use(middlewares.RedisRateLimiter(1, 5))
 
+	// Replay responses of retried writes that carry an Idempotency-Key for 24 hours
+	router.Use(middlewares.Idempotency(24 * time.Hour))
+
 	routes.RegisterRoutes(router)
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
+	// Background jobs stop when the server shuts down
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	jobs.StartReservationSweeper(jobsCtx, db, 30*time.Second)
+	jobs.StartIdempotencyCleanup(jobsCtx, db, time.Hour)
+	jobs.StartWebhookDelivery(jobsCtx, db, 2*time.Second)
+
+	// Domain events are relayed to the EVENT_STREAM Redis Stream (default
+	// inventory:events) and kept in the outbox for a week after publishing
+	if stream := os.Getenv("EVENT_STREAM"); stream != "" {
+		services.EventStream = stream
+	}
+	jobs.StartOutboxRelay(jobsCtx, db, middlewares.RedisClient(), time.Second, 7*24*time.Hour)
+
+	// Relayed events reach this replica's live streams through Redis pub/sub
+	events.Listen(jobsCtx, middlewares.RedisClient(), services.EventChannel)
+
+	// Soft-deleted items are purged after ITEM_RETENTION_DAYS (default 30)
+	retentionDays, err := strconv.Atoi(os.Getenv("ITEM_RETENTION_DAYS"))
+	if err != nil || retentionDays < 1 {
+		retentionDays = 30
+	}
+	jobs.StartTrashRetention(jobsCtx, db, time.Duration(retentionDays)*24*time.Hour, time.Hour)
+
+	// Purchase orders for items below their reorder point are drafted every
+	// REPLENISHMENT_INTERVAL_MINUTES; the job is off when it is unset
+	if minutes, err := strconv.Atoi(os.Getenv("REPLENISHMENT_INTERVAL_MINUTES")); err == nil && minutes > 0 {
+		jobs.StartReplenishment(jobsCtx, db, time.Duration(minutes)*time.Minute)
+	}
+
 	srv := &http.Server{Addr: ":8080", Handler: router}
+	// Live streams never finish on their own, so they are closed when shutdown starts
+	srv.RegisterOnShutdown(events.Shutdown)
 
 	go func() {
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -78,17 +176,53 @@ func main() {
 
 	log.Println("server is running on http://localhost:8080")
 
+	// The gRPC API listens on GRPC_PORT (default 9090)
+	grpcPort := os.Getenv("GRPC_PORT")
+	if grpcPort == "" {
+		grpcPort = "9090"
+	}
+	listener, err := net.Listen("tcp", ":"+grpcPort)
+	if err != nil {
+		log.Fatalf("grpc listen: %v", err)
+	}
+	// Same limit as the REST API (1 req/sec, burst 5), per caller
+	grpcServer := grpcserver.New(1, 5)
+
+	go func() {
+		if err := grpcServer.Serve(listener); err != nil {
+			log.Fatalf("grpc serve: %v", err)
+		}
+	}()
+
+	log.Printf("gRPC server is running on localhost:%s", grpcPort)
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	log.Println("shutting down server...")
+	stopJobs()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	if err := srv.Shutdown(ctx); err != nil {
-		log.Fatalf("server forced to shutdown: %v", err)
+	// Live streams are closed first so GracefulStop only waits for unary calls in
+	// flight; gRPC calls still running at the deadline are cut off
+	events.Shutdown()
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+
+	shutdownErr := srv.Shutdown(ctx)
+	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+		grpcServer.Stop()
+	}
+	if shutdownErr != nil {
+		log.Fatalf("server forced to shutdown: %v", shutdownErr)
 	}
 
 	log.Println("server exiting")
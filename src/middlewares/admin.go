@@ -0,0 +1,24 @@
+package middlewares
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"os"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireAdmin only lets through requests whose X-Admin-Token header matches the
+// ADMIN_TOKEN environment variable. Admin routes are closed when it is unset.
+func RequireAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		expected := os.Getenv("ADMIN_TOKEN")
+		provided := c.GetHeader("X-Admin-Token")
+		if expected == "" || subtle.ConstantTimeCompare([]byte(provided), []byte(expected)) != 1 {
+			c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
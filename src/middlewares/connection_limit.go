@@ -0,0 +1,66 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrTooManyConnections is returned when an identity already holds its limit of
+// long-lived connections.
+var ErrTooManyConnections = errors.New("too many open connections")
+
+// Slots not refreshed for this long belong to replicas that died and are reclaimed
+const connectionSlotTTL = 2 * time.Minute
+
+// ConnectionSlot is one of an identity's long-lived connections, counted across
+// every replica in a Redis sorted set scored by the slot's last refresh.
+type ConnectionSlot struct {
+	key string
+	id  string
+}
+
+// AcquireConnectionSlot claims one of limit concurrent connections of kind for
+// identity, such as a client IP. The slot must be refreshed more often than every two
+// minutes and released when the connection ends.
+func AcquireConnectionSlot(ctx context.Context, kind, identity string, limit int) (*ConnectionSlot, error) {
+	slot := &ConnectionSlot{
+		key: fmt.Sprintf("conn_limit:%s:%s", kind, identity),
+		id:  uuid.NewString(),
+	}
+
+	now := time.Now()
+	pipe := redisClient.TxPipeline()
+	pipe.ZRemRangeByScore(ctx, slot.key, "-inf", strconv.FormatInt(now.Add(-connectionSlotTTL).Unix(), 10))
+	pipe.ZAdd(ctx, slot.key, redis.Z{Score: float64(now.Unix()), Member: slot.id})
+	held := pipe.ZCard(ctx, slot.key)
+	pipe.Expire(ctx, slot.key, connectionSlotTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if held.Val() > int64(limit) {
+		slot.Release(ctx)
+		return nil, ErrTooManyConnections
+	}
+	return slot, nil
+}
+
+// Refresh marks the slot as still in use.
+func (slot *ConnectionSlot) Refresh(ctx context.Context) error {
+	pipe := redisClient.TxPipeline()
+	pipe.ZAdd(ctx, slot.key, redis.Z{Score: float64(time.Now().Unix()), Member: slot.id})
+	pipe.Expire(ctx, slot.key, connectionSlotTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Release frees the slot.
+func (slot *ConnectionSlot) Release(ctx context.Context) {
+	redisClient.ZRem(ctx, slot.key, slot.id)
+}
@@ -0,0 +1,309 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"inventory-service/src/models"
+	"inventory-service/src/utils"
+)
+
+const maxIdempotencyKeyLength = 255
+
+// Context key of the body ReplayWith stores instead of the response
+const replayBodyKey = "idempotency_replay_body"
+
+// Only requests that change state are deduplicated
+var idempotentMethods = map[string]bool{
+	http.MethodPost:   true,
+	http.MethodPut:    true,
+	http.MethodPatch:  true,
+	http.MethodDelete: true,
+}
+
+// idempotencyEntry is what is remembered about a request carrying an Idempotency-Key.
+type idempotencyEntry struct {
+	Fingerprint string `json:"fingerprint"`
+	Completed   bool   `json:"completed"`
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type"`
+	ETag        string `json:"etag,omitempty"`
+	Body        []byte `json:"body"`
+}
+
+type idempotencyStore interface {
+	// claim records entry under key unless the key is already taken, in which
+	// case the existing entry is returned.
+	claim(ctx context.Context, key string, entry idempotencyEntry, ttl time.Duration) (*idempotencyEntry, error)
+	complete(ctx context.Context, key string, entry idempotencyEntry, ttl time.Duration) error
+	release(ctx context.Context, key string) error
+}
+
+// Idempotency replays the stored response of a write request whose Idempotency-Key
+// was seen within ttl. Reusing a key with a different payload returns 422, and a
+// key whose first request is still running returns 409. Responses are kept in
+// Redis, falling back to Postgres when Redis is unavailable.
+func Idempotency(ttl time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := strings.TrimSpace(c.GetHeader("Idempotency-Key"))
+		if key == "" || !idempotentMethods[c.Request.Method] {
+			c.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Idempotency-Key must be at most 255 characters"})
+			c.Abort()
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		// Keys are scoped to the caller so clients cannot collide with each other, and
+		// hashed so that long caller IDs and keys still fit the Postgres primary key
+		scope := c.GetString("user_id")
+		if scope == "" {
+			scope = "anonymous"
+		}
+		scoped := sha256.Sum256([]byte(scope + "\n" + key))
+		storeKey := "idempotency:" + hex.EncodeToString(scoped[:])
+
+		hash := sha256.New()
+		hash.Write([]byte(c.Request.Method + " " + c.Request.URL.RequestURI() + "\n"))
+		hash.Write(body)
+		fingerprint := hex.EncodeToString(hash.Sum(nil))
+
+		store, existing, err := claimIdempotencyKey(c.Request.Context(), storeKey, fingerprint, ttl)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "idempotency store error"})
+			c.Abort()
+			return
+		}
+
+		if existing != nil {
+			switch {
+			case existing.Fingerprint != fingerprint:
+				c.JSON(http.StatusUnprocessableEntity, gin.H{"error": "Idempotency-Key was already used with a different request"})
+			case !existing.Completed:
+				c.JSON(http.StatusConflict, gin.H{"error": "a request with this Idempotency-Key is still being processed"})
+			default:
+				c.Header("Idempotent-Replayed", "true")
+				if existing.ETag != "" {
+					c.Header("ETag", existing.ETag)
+				}
+				c.Data(existing.StatusCode, existing.ContentType, existing.Body)
+			}
+			c.Abort()
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+
+		// Server errors and panics are not remembered so the client can retry with
+		// the same key
+		stored := false
+		defer func() {
+			if stored {
+				return
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := store.release(ctx, storeKey); err != nil {
+				log.Printf("idempotency: failed to release key: %v", err)
+			}
+		}()
+
+		c.Next()
+		if recorder.Status() >= http.StatusInternalServerError {
+			return
+		}
+
+		entry := idempotencyEntry{
+			Fingerprint: fingerprint,
+			Completed:   true,
+			StatusCode:  recorder.Status(),
+			ContentType: recorder.Header().Get("Content-Type"),
+			ETag:        recorder.Header().Get("ETag"),
+			Body:        recorder.body.Bytes(),
+		}
+		if replay, ok := c.Get(replayBodyKey); ok {
+			if entry.Body, err = json.Marshal(replay); err != nil {
+				log.Printf("idempotency: failed to encode replay body: %v", err)
+				return
+			}
+		}
+
+		// The client may already be gone; the outcome must still be stored
+		stored = true
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := store.complete(ctx, storeKey, entry, ttl); err != nil {
+			log.Printf("idempotency: failed to store response: %v", err)
+		}
+	}
+}
+
+// ReplayWith makes body, encoded as JSON, the response replayed to retries of the
+// request in place of the one sent. Handlers use it to keep secrets they reveal
+// once out of the idempotency store.
+func ReplayWith(c *gin.Context, body interface{}) {
+	c.Set(replayBodyKey, body)
+}
+
+// claimIdempotencyKey tries Redis first and falls back to Postgres.
+func claimIdempotencyKey(ctx context.Context, key, fingerprint string, ttl time.Duration) (idempotencyStore, *idempotencyEntry, error) {
+	pending := idempotencyEntry{Fingerprint: fingerprint}
+
+	if redisClient != nil {
+		store := redisIdempotencyStore{client: redisClient}
+		existing, err := store.claim(ctx, key, pending, ttl)
+		if err == nil {
+			return store, existing, nil
+		}
+		log.Printf("idempotency: redis unavailable, falling back to postgres: %v", err)
+	}
+
+	store := postgresIdempotencyStore{db: utils.ConnectDatabase()}
+	existing, err := store.claim(ctx, key, pending, ttl)
+	return store, existing, err
+}
+
+type redisIdempotencyStore struct {
+	client *redis.Client
+}
+
+func (store redisIdempotencyStore) claim(ctx context.Context, key string, entry idempotencyEntry, ttl time.Duration) (*idempotencyEntry, error) {
+	payload, err := json.Marshal(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	// The key can expire between SETNX and GET, so try twice
+	for attempt := 0; attempt < 2; attempt++ {
+		claimed, err := store.client.SetNX(ctx, key, payload, ttl).Result()
+		if err != nil {
+			return nil, err
+		}
+		if claimed {
+			return nil, nil
+		}
+
+		raw, err := store.client.Get(ctx, key).Bytes()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var existing idempotencyEntry
+		if err := json.Unmarshal(raw, &existing); err != nil {
+			return nil, err
+		}
+		return &existing, nil
+	}
+	return nil, errors.New("idempotency key changed concurrently")
+}
+
+func (store redisIdempotencyStore) complete(ctx context.Context, key string, entry idempotencyEntry, ttl time.Duration) error {
+	payload, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return store.client.Set(ctx, key, payload, ttl).Err()
+}
+
+func (store redisIdempotencyStore) release(ctx context.Context, key string) error {
+	return store.client.Del(ctx, key).Err()
+}
+
+type postgresIdempotencyStore struct {
+	db *gorm.DB
+}
+
+func (store postgresIdempotencyStore) claim(ctx context.Context, key string, entry idempotencyEntry, ttl time.Duration) (*idempotencyEntry, error) {
+	db := store.db.WithContext(ctx)
+
+	// An expired key is free to be claimed again
+	if err := db.Where("key = ? AND expires_at < ?", key, time.Now()).Delete(&models.IdempotencyKey{}).Error; err != nil {
+		return nil, err
+	}
+
+	record := models.IdempotencyKey{
+		Key:         key,
+		Fingerprint: entry.Fingerprint,
+		ExpiresAt:   time.Now().Add(ttl),
+	}
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&record)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 1 {
+		return nil, nil
+	}
+
+	if err := db.First(&record, "key = ?", key).Error; err != nil {
+		return nil, err
+	}
+	return &idempotencyEntry{
+		Fingerprint: record.Fingerprint,
+		Completed:   record.Completed,
+		StatusCode:  record.StatusCode,
+		ContentType: record.ContentType,
+		ETag:        record.ETag,
+		Body:        record.Body,
+	}, nil
+}
+
+func (store postgresIdempotencyStore) complete(ctx context.Context, key string, entry idempotencyEntry, ttl time.Duration) error {
+	return store.db.WithContext(ctx).Model(&models.IdempotencyKey{}).
+		Where("key = ?", key).
+		Updates(map[string]interface{}{
+			"completed":    true,
+			"status_code":  entry.StatusCode,
+			"content_type": entry.ContentType,
+			"etag":         entry.ETag,
+			"body":         entry.Body,
+			"expires_at":   time.Now().Add(ttl),
+		}).Error
+}
+
+func (store postgresIdempotencyStore) release(ctx context.Context, key string) error {
+	return store.db.WithContext(ctx).Where("key = ?", key).Delete(&models.IdempotencyKey{}).Error
+}
+
+// responseRecorder keeps a copy of the response body while it is written.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (recorder *responseRecorder) Write(data []byte) (int, error) {
+	recorder.body.Write(data)
+	return recorder.ResponseWriter.Write(data)
+}
+
+func (recorder *responseRecorder) WriteString(data string) (int, error) {
+	recorder.body.WriteString(data)
+	return recorder.ResponseWriter.WriteString(data)
+}
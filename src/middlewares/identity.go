@@ -0,0 +1,18 @@
+package middlewares
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Identity exposes the caller supplied in the X-User-ID header as "user_id" on the
+// context, which is what RedisRateLimiterByUser and the stock ledger key on.
+func Identity() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if userID := strings.TrimSpace(c.GetHeader("X-User-ID")); userID != "" {
+			c.Set("user_id", userID)
+		}
+		c.Next()
+	}
+}
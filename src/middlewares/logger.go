@@ -6,4 +6,6 @@ import "github.com/gin-gonic/gin"
 func Register(router *gin.Engine) {
 	router.Use(gin.Logger())
 	router.Use(gin.Recovery())
+	router.Use(RequestID())
+	router.Use(Identity())
 }
@@ -88,6 +88,15 @@ func RedisRateLimiter(requestsPerSecond int, burst int) gin.HandlerFunc {
 	}
 }
 
+// UserIdentity is who RedisRateLimiterByUser limits: the user ID from the context
+// (set by the Identity middleware), or the client IP for anonymous requests
+func UserIdentity(c *gin.Context) string {
+	if userID, exists := c.Get("user_id"); exists {
+		return fmt.Sprint(userID)
+	}
+	return c.ClientIP()
+}
+
 // RedisRateLimiterByUser creates rate limiting based on authenticated user
 func RedisRateLimiterByUser(requestsPerSecond int, burst int) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -96,21 +105,7 @@ func RedisRateLimiterByUser(requestsPerSecond int, burst int) gin.HandlerFunc {
 			return
 		}
 
-		ctx := c.Request.Context()
-
-		// Extract user ID from context (set by auth middleware)
-		userID, exists := c.Get("user_id")
-		if !exists {
-			// Fall back to IP-based limiting for unauthenticated requests
-			userID = c.ClientIP()
-		}
-
-		key := fmt.Sprintf("rate_limit:user:%v", userID)
-
-		limit := redis_rate.PerSecond(requestsPerSecond)
-		limit.Burst = burst
-
-		result, err := rateLimiter.Allow(ctx, key, limit)
+		result, err := AllowUser(c.Request.Context(), UserIdentity(c), requestsPerSecond, burst)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"error": "rate limiter error",
@@ -135,6 +130,20 @@ func RedisRateLimiterByUser(requestsPerSecond int, burst int) gin.HandlerFunc {
 	}
 }
 
+// AllowUser takes one request from the bucket RedisRateLimiterByUser keeps for
+// identity, so callers outside gin, such as the gRPC server, share the same limit
+func AllowUser(ctx context.Context, identity string, requestsPerSecond int, burst int) (*redis_rate.Result, error) {
+	limit := redis_rate.PerSecond(requestsPerSecond)
+	limit.Burst = burst
+	return rateLimiter.Allow(ctx, fmt.Sprintf("rate_limit:user:%s", identity), limit)
+}
+
+// RedisClient returns the Redis connection opened by InitRedisRateLimiter so other
+// components can share it
+func RedisClient() *redis.Client {
+	return redisClient
+}
+
 // CloseRedis closes the Redis connection
 func CloseRedis() error {
 	if redisClient != nil {
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Alert events.
+const (
+	AlertLowStock   = "low_stock"
+	AlertOutOfStock = "out_of_stock"
+	AlertRecovered  = "recovered"
+)
+
+// Alert levels of an item under a rule: available stock above the threshold, at
+// or below it, or at or below zero.
+const (
+	AlertLevelOK  = "ok"
+	AlertLevelLow = "low"
+	AlertLevelOut = "out"
+)
+
+// AlertRule fires when an item's available stock falls to or below Threshold.
+// Rules without an item apply to every item.
+type AlertRule struct {
+	ID        string    `json:"id" gorm:"type:uuid;primary_key"`
+	Name      string    `json:"name" gorm:"type:varchar(255);not null" example:"Low laptop stock"`
+	ItemID    *string   `json:"item_id" gorm:"type:uuid;index"`
+	Threshold int       `json:"threshold" gorm:"not null" example:"5"`
+	Enabled   bool      `json:"enabled" gorm:"not null"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// AlertState remembers an item's alert level under a rule so each change of level
+// produces a single alert.
+type AlertState struct {
+	RuleID    string `gorm:"type:uuid;primaryKey"`
+	ItemID    string `gorm:"type:uuid;primaryKey;index"`
+	Level     string `gorm:"type:varchar(8);not null;default:ok"`
+	UpdatedAt time.Time
+}
+
+// Alert is a change of an item's alert level: low_stock when available stock
+// falls to or below a rule's threshold, out_of_stock when it reaches zero and
+// recovered when it rises back above the threshold. Each alert is also emitted as
+// an alert.<event> domain event.
+type Alert struct {
+	ID        string    `json:"id" gorm:"type:uuid;primary_key"`
+	RuleID    string    `json:"rule_id" gorm:"type:uuid;not null;index"`
+	ItemID    string    `json:"item_id" gorm:"type:uuid;not null;index"`
+	Event     string    `json:"event" gorm:"type:varchar(16);not null;index" example:"low_stock"`
+	Threshold int       `json:"threshold" example:"5"`
+	Stock     int       `json:"stock" example:"4"`
+	Available int       `json:"available" example:"3"`
+	CreatedAt time.Time `json:"created_at" gorm:"index"`
+}
+
+// Generating UUID for each alert rule
+func (rule *AlertRule) BeforeCreate(tx *gorm.DB) error {
+	if rule.ID == "" {
+		rule.ID = uuid.NewString()
+	}
+	return nil
+}
+
+// Generating UUID for each alert
+func (alert *Alert) BeforeCreate(tx *gorm.DB) error {
+	if alert.ID == "" {
+		alert.ID = uuid.NewString()
+	}
+	return nil
+}
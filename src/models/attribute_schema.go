@@ -0,0 +1,68 @@
+package models
+
+import (
+	"database/sql/driver"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Attribute types an attribute schema can declare
+const (
+	AttributeString  = "string"
+	AttributeNumber  = "number"
+	AttributeInteger = "integer"
+	AttributeBoolean = "boolean"
+	AttributeEnum    = "enum"
+)
+
+// AttributeSchema names a set of typed custom attributes, such as "apparel" with
+// color and size, that items assigned to it must satisfy.
+type AttributeSchema struct {
+	ID          string          `json:"id" gorm:"type:uuid;primary_key"`
+	Name        string          `json:"name" gorm:"type:varchar(64);not null;uniqueIndex" example:"apparel"`
+	Description string          `json:"description" gorm:"type:text" example:"Clothing sizes and colours"`
+	Fields      AttributeFields `json:"fields" gorm:"not null"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// AttributeField declares one attribute of a schema.
+type AttributeField struct {
+	Name     string   `json:"name" binding:"required" example:"size"`
+	Type     string   `json:"type" binding:"required,oneof=string number integer boolean enum" example:"enum"`
+	Required bool     `json:"required" example:"true"`
+	Values   []string `json:"values,omitempty" example:"S,M,L,XL"`
+	Min      *float64 `json:"min,omitempty"`
+	Max      *float64 `json:"max,omitempty"`
+}
+
+// AttributeFields is the list of fields of a schema, stored as jsonb.
+type AttributeFields []AttributeField
+
+// Value implements driver.Valuer
+func (f AttributeFields) Value() (driver.Value, error) {
+	if f == nil {
+		return "[]", nil
+	}
+	return marshalValue(f)
+}
+
+// Scan implements sql.Scanner
+func (f *AttributeFields) Scan(value interface{}) error {
+	return scanJSON(value, f)
+}
+
+// GormDataType stores AttributeFields as jsonb in Postgres
+func (AttributeFields) GormDataType() string {
+	return "jsonb"
+}
+
+// Generating UUID for each attribute schema
+func (schema *AttributeSchema) BeforeCreate(tx *gorm.DB) error {
+	if schema.ID == "" {
+		schema.ID = uuid.NewString()
+	}
+	return nil
+}
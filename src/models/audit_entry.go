@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Audited item actions.
+const (
+	AuditCreate  = "create"
+	AuditUpdate  = "update"
+	AuditDelete  = "delete"
+	AuditRestore = "restore"
+	AuditPurge   = "purge"
+)
+
+// AuditEntry records who changed an item, from where, and the before/after value
+// of every field that changed.
+type AuditEntry struct {
+	ID        string    `json:"id" gorm:"type:uuid;primary_key"`
+	ItemID    string    `json:"item_id" gorm:"type:uuid;not null;index"`
+	Action    string    `json:"action" gorm:"type:varchar(16);not null;index"`
+	Actor     string    `json:"actor" gorm:"type:varchar(255);index"`
+	RequestID string    `json:"request_id" gorm:"type:varchar(64)"`
+	ClientIP  string    `json:"client_ip" gorm:"type:varchar(64)"`
+	Changes   JSON      `json:"changes" swaggertype:"object"`
+	CreatedAt time.Time `json:"created_at" gorm:"index"`
+}
+
+// FieldChange is the before and after value of one field in an audit entry.
+type FieldChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// Generating UUID for each audit entry
+func (entry *AuditEntry) BeforeCreate(tx *gorm.DB) error {
+	if entry.ID == "" {
+		entry.ID = uuid.NewString()
+	}
+	return nil
+}
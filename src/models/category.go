@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Category groups items in a tree, such as Electronics > Audio > Headphones.
+// Root categories have no parent.
+type Category struct {
+	ID        string    `json:"id" gorm:"type:uuid;primary_key"`
+	Name      string    `json:"name" gorm:"type:varchar(255);not null;uniqueIndex:idx_categories_parent_name"`
+	ParentID  *string   `json:"parent_id" gorm:"type:uuid;index;uniqueIndex:idx_categories_parent_name"`
+	Parent    *Category `json:"-" gorm:"constraint:OnDelete:RESTRICT"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Generating UUID for each category
+func (category *Category) BeforeCreate(tx *gorm.DB) error {
+	if category.ID == "" {
+		category.ID = uuid.NewString()
+	}
+	return nil
+}
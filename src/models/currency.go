@@ -0,0 +1,21 @@
+package models
+
+// DefaultCurrency is assumed for items created without an explicit currency.
+const DefaultCurrency = "USD"
+
+// ISO 4217 currencies whose minor unit is not the usual two decimal places
+var currencyMinorUnits = map[string]int32{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0,
+	"XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4, "UYW": 4,
+}
+
+// CurrencyMinorUnits returns the number of decimal places prices in the currency may have.
+func CurrencyMinorUnits(code string) int32 {
+	if units, ok := currencyMinorUnits[code]; ok {
+		return units
+	}
+	return 2
+}
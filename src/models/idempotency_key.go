@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// IdempotencyKey is the Postgres copy of a replayable response, used when Redis
+// is unavailable.
+type IdempotencyKey struct {
+	Key         string    `json:"key" gorm:"type:varchar(320);primary_key"`
+	Fingerprint string    `json:"fingerprint" gorm:"type:char(64);not null"`
+	Completed   bool      `json:"completed" gorm:"not null;default:false"`
+	StatusCode  int       `json:"status_code"`
+	ContentType string    `json:"content_type" gorm:"type:varchar(255)"`
+	ETag        string    `json:"etag" gorm:"column:etag;type:varchar(255)"`
+	Body        []byte    `json:"-"`
+	ExpiresAt   time.Time `json:"expires_at" gorm:"not null;index"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -4,17 +4,37 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
-
 type Item struct {
-	ID        string    `json:"id" gorm:"type:uuid;primary_key"`
-	Name      string    `json:"name" gorm:"type:varchar(255);not null"`
-	Stock     int       `json:"stock" gorm:"not null"`
-	Price     float64   `json:"price" gorm:"not null"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID                string           `json:"id" gorm:"type:uuid;primary_key"`
+	SKU               string           `json:"sku" gorm:"type:varchar(64);uniqueIndex" example:"LAPTOP-001"`
+	Barcode           *string          `json:"barcode,omitempty" gorm:"type:varchar(14);uniqueIndex" example:"4006381333931"`
+	Name              string           `json:"name" gorm:"type:varchar(255);not null"`
+	CategoryID        *string          `json:"category_id" gorm:"type:uuid;index"`
+	Category          *Category        `json:"category,omitempty" gorm:"constraint:OnDelete:RESTRICT"`
+	Tags              StringList       `json:"tags" gorm:"not null;default:'[]';index:idx_items_tags,type:gin" swaggertype:"array,string" example:"wireless,clearance"`
+	Attributes        Attributes       `json:"attributes" gorm:"not null;default:'{}';index:idx_items_attributes,type:gin" swaggertype:"object"`
+	AttributeSchemaID *string          `json:"attribute_schema_id" gorm:"type:uuid;index"`
+	AttributeSchema   *AttributeSchema `json:"-" gorm:"constraint:OnDelete:RESTRICT"`
+	Stock             int              `json:"stock" gorm:"not null"`
+	Reserved          int              `json:"reserved" gorm:"not null;default:0"`
+	InTransit         int              `json:"in_transit" gorm:"not null;default:0"`
+	Quarantined       int              `json:"quarantined" gorm:"not null;default:0"`
+	ReorderPoint      int              `json:"reorder_point" gorm:"not null;default:0" example:"5"`
+	ReorderQuantity   int              `json:"reorder_quantity" gorm:"not null;default:0" example:"20"`
+	SafetyStock       int              `json:"safety_stock" gorm:"not null;default:0" example:"2"`
+	OnHand            int              `json:"on_hand" gorm:"-"`
+	Available         int              `json:"available" gorm:"-"`
+	Price             decimal.Decimal  `json:"price" gorm:"type:numeric(19,4);not null" swaggertype:"string" example:"999.99"`
+	Currency          string           `json:"currency" gorm:"type:char(3);not null;default:'USD'" example:"USD"`
+	Version           int              `json:"version" gorm:"not null;default:1"`
+	Locations         []ItemStock      `json:"locations,omitempty" gorm:"foreignKey:ItemID"`
+	CreatedAt         time.Time        `json:"created_at"`
+	UpdatedAt         time.Time        `json:"updated_at"`
+	DeletedAt         gorm.DeletedAt   `json:"deleted_at" gorm:"index" swaggertype:"string" format:"date-time"`
 }
 
 // Generating UUID for each item
@@ -24,3 +44,19 @@ func (item *Item) BeforeCreate(tx *gorm.DB) error {
 	}
 	return nil
 }
+
+// Deriving on-hand and available quantities after every load or write
+func (item *Item) AfterFind(tx *gorm.DB) error {
+	item.refreshQuantities()
+	return nil
+}
+
+func (item *Item) AfterSave(tx *gorm.DB) error {
+	item.refreshQuantities()
+	return nil
+}
+
+func (item *Item) refreshQuantities() {
+	item.OnHand = item.Stock
+	item.Available = item.Stock - item.Reserved
+}
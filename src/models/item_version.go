@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ItemVersion is a snapshot of an item's name, stock and price taken whenever
+// they change, used to answer point-in-time ("as of") queries.
+type ItemVersion struct {
+	ID            uint64          `json:"-" gorm:"primaryKey"`
+	ItemID        string          `json:"item_id" gorm:"type:uuid;not null;index:idx_item_versions_item_recorded,priority:1"`
+	Version       int             `json:"version" gorm:"not null"`
+	Name          string          `json:"name" gorm:"type:varchar(255);not null"`
+	Stock         int             `json:"stock" gorm:"not null"`
+	Price         decimal.Decimal `json:"price" gorm:"type:numeric(19,4);not null" swaggertype:"string"`
+	Currency      string          `json:"currency" gorm:"type:char(3);not null;default:'USD'"`
+	Deleted       bool            `json:"deleted" gorm:"not null;default:false"`
+	ItemCreatedAt time.Time       `json:"item_created_at" gorm:"not null"`
+	RecordedAt    time.Time       `json:"recorded_at" gorm:"not null;index:idx_item_versions_item_recorded,priority:2;index"`
+}
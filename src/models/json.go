@@ -0,0 +1,117 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+)
+
+// JSON is a raw JSON document stored in a jsonb column.
+type JSON json.RawMessage
+
+// Value implements driver.Valuer
+func (j JSON) Value() (driver.Value, error) {
+	if len(j) == 0 {
+		return nil, nil
+	}
+	return string(j), nil
+}
+
+// Scan implements sql.Scanner
+func (j *JSON) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*j = nil
+	case []byte:
+		*j = append((*j)[:0], v...)
+	case string:
+		*j = JSON(v)
+	default:
+		return errors.New("unsupported type for JSON column")
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (j JSON) MarshalJSON() ([]byte, error) {
+	if len(j) == 0 {
+		return []byte("null"), nil
+	}
+	return j, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (j *JSON) UnmarshalJSON(data []byte) error {
+	*j = append((*j)[:0], data...)
+	return nil
+}
+
+// GormDataType stores JSON as jsonb in Postgres
+func (JSON) GormDataType() string {
+	return "jsonb"
+}
+
+// StringList is a list of strings stored as a jsonb array.
+type StringList []string
+
+// Value implements driver.Valuer
+func (l StringList) Value() (driver.Value, error) {
+	if l == nil {
+		return "[]", nil
+	}
+	return marshalValue(l)
+}
+
+// Scan implements sql.Scanner
+func (l *StringList) Scan(value interface{}) error {
+	return scanJSON(value, l)
+}
+
+// GormDataType stores StringList as jsonb in Postgres
+func (StringList) GormDataType() string {
+	return "jsonb"
+}
+
+// Attributes is a flat set of named custom values stored as a jsonb object.
+type Attributes map[string]interface{}
+
+// Value implements driver.Valuer
+func (a Attributes) Value() (driver.Value, error) {
+	if a == nil {
+		return "{}", nil
+	}
+	return marshalValue(a)
+}
+
+// Scan implements sql.Scanner
+func (a *Attributes) Scan(value interface{}) error {
+	// Unmarshalling merges into an existing map, so start from an empty one
+	*a = nil
+	return scanJSON(value, a)
+}
+
+// GormDataType stores Attributes as jsonb in Postgres
+func (Attributes) GormDataType() string {
+	return "jsonb"
+}
+
+func marshalValue(v interface{}) (driver.Value, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func scanJSON(value interface{}, dest interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, dest)
+	case string:
+		return json.Unmarshal([]byte(v), dest)
+	default:
+		return errors.New("unsupported type for JSON column")
+	}
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Location is a warehouse or other place where stock is physically held.
+type Location struct {
+	ID        string    `json:"id" gorm:"type:uuid;primary_key"`
+	Code      string    `json:"code" gorm:"type:varchar(32);not null;uniqueIndex"`
+	Name      string    `json:"name" gorm:"type:varchar(255);not null"`
+	Address   string    `json:"address" gorm:"type:text"`
+	IsDefault bool      `json:"is_default" gorm:"not null;default:false"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ItemStock is the quantity of one item held at one location.
+type ItemStock struct {
+	ItemID     string    `json:"item_id" gorm:"type:uuid;primaryKey"`
+	LocationID string    `json:"location_id" gorm:"type:uuid;primaryKey;index"`
+	Quantity   int       `json:"quantity" gorm:"not null;default:0"`
+	Location   *Location `json:"location,omitempty" gorm:"constraint:OnDelete:RESTRICT"`
+	Item       *Item     `json:"item,omitempty"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// Generating UUID for each location
+func (location *Location) BeforeCreate(tx *gorm.DB) error {
+	if location.ID == "" {
+		location.ID = uuid.NewString()
+	}
+	return nil
+}
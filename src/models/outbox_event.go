@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Domain event types.
+const (
+	EventItemCreated  = "item.created"
+	EventItemUpdated  = "item.updated"
+	EventItemDeleted  = "item.deleted"
+	EventStockChanged = "stock.changed"
+	// stock.reserved is emitted when reservations or sales orders hold or release
+	// units, which changes available stock but not stock.
+	EventStockReserved = "stock.reserved"
+
+	EventAlertLowStock   = "alert." + AlertLowStock
+	EventAlertOutOfStock = "alert." + AlertOutOfStock
+	EventAlertRecovered  = "alert." + AlertRecovered
+)
+
+// ValidEventType reports whether eventType is a known domain event type.
+func ValidEventType(eventType string) bool {
+	switch eventType {
+	case EventItemCreated, EventItemUpdated, EventItemDeleted, EventStockChanged, EventStockReserved,
+		EventAlertLowStock, EventAlertOutOfStock, EventAlertRecovered:
+		return true
+	}
+	return false
+}
+
+// OutboxEvent is a domain event. It is written in the transaction that made the
+// change and later published to the event stream by the outbox relay, and its JSON
+// form is the envelope consumers receive. Sequence is taken when the event is
+// written, so events of concurrent transactions may commit, and be published, out
+// of sequence order; events about one item are in sequence and aggregate version
+// order. Position is given by the relay as it publishes the event and increases in
+// publish order, so it is what consumers checkpoint on. Webhook payloads are
+// written with the event and carry no position.
+type OutboxEvent struct {
+	Sequence         int64      `json:"sequence" gorm:"primaryKey;autoIncrement" example:"1042"`
+	Position         *int64     `json:"position,omitempty" gorm:"uniqueIndex" example:"1040"`
+	ID               string     `json:"id" gorm:"type:uuid;not null;uniqueIndex"`
+	Type             string     `json:"type" gorm:"type:varchar(64);not null;index" example:"stock.changed"`
+	AggregateType    string     `json:"aggregate_type" gorm:"type:varchar(32);not null" example:"item"`
+	AggregateID      string     `json:"aggregate_id" gorm:"type:uuid;not null;index"`
+	AggregateVersion int        `json:"aggregate_version" example:"7"`
+	Actor            string     `json:"actor" gorm:"type:varchar(255)"`
+	RequestID        string     `json:"request_id,omitempty" gorm:"type:varchar(64)"`
+	Data             JSON       `json:"data" swaggertype:"object"`
+	OccurredAt       time.Time  `json:"occurred_at" gorm:"not null"`
+	PublishedAt      *time.Time `json:"-" gorm:"index"`
+}
+
+// Generating UUID and timestamp for each outbox event
+func (event *OutboxEvent) BeforeCreate(tx *gorm.DB) error {
+	if event.ID == "" {
+		event.ID = uuid.NewString()
+	}
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+	return nil
+}
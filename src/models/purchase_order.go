@@ -0,0 +1,76 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// Purchase order lifecycle states.
+const (
+	PurchaseDraft             = "draft"
+	PurchaseSent              = "sent"
+	PurchasePartiallyReceived = "partially_received"
+	PurchaseReceived          = "received"
+	PurchaseCancelled         = "cancelled"
+)
+
+// PurchaseOrder is an order for items placed with a supplier. Its lines are priced
+// in the supplier's currency and received into LocationID, or the default location
+// when it is empty.
+type PurchaseOrder struct {
+	ID          string              `json:"id" gorm:"type:uuid;primary_key"`
+	SupplierID  string              `json:"supplier_id" gorm:"type:uuid;not null;index"`
+	Supplier    *Supplier           `json:"supplier,omitempty" gorm:"constraint:OnDelete:RESTRICT"`
+	LocationID  *string             `json:"location_id,omitempty" gorm:"type:uuid"`
+	Currency    string              `json:"currency" gorm:"type:char(3);not null" example:"USD"`
+	Status      string              `json:"status" gorm:"type:varchar(24);not null;index"`
+	Reference   string              `json:"reference" gorm:"type:varchar(255)" example:"PO-2024-0042"`
+	Notes       string              `json:"notes" gorm:"type:text"`
+	CreatedBy   string              `json:"created_by" gorm:"type:varchar(255)"`
+	Lines       []PurchaseOrderLine `json:"lines" gorm:"constraint:OnDelete:CASCADE"`
+	SentAt      *time.Time          `json:"sent_at"`
+	ReceivedAt  *time.Time          `json:"received_at"`
+	CancelledAt *time.Time          `json:"cancelled_at"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
+// PurchaseOrderLine is the quantity of one item ordered on a purchase order and how
+// much of it has been received so far.
+type PurchaseOrderLine struct {
+	ID               string          `json:"id" gorm:"type:uuid;primary_key"`
+	PurchaseOrderID  string          `json:"purchase_order_id" gorm:"type:uuid;not null;uniqueIndex:idx_purchase_order_lines_item"`
+	ItemID           string          `json:"item_id" gorm:"type:uuid;not null;uniqueIndex:idx_purchase_order_lines_item;index"`
+	Item             *Item           `json:"item,omitempty" gorm:"constraint:OnDelete:RESTRICT"`
+	Position         int             `json:"position" gorm:"not null" example:"1"`
+	Quantity         int             `json:"quantity" gorm:"not null" example:"50"`
+	QuantityReceived int             `json:"quantity_received" gorm:"not null;default:0"`
+	UnitCost         decimal.Decimal `json:"unit_cost" gorm:"type:numeric(19,4);not null" swaggertype:"string" example:"812.50"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+}
+
+// Outstanding returns the units still expected, never negative once a line has
+// been over-received.
+func (line *PurchaseOrderLine) Outstanding() int {
+	return max(line.Quantity-line.QuantityReceived, 0)
+}
+
+// Generating UUID for each purchase order
+func (order *PurchaseOrder) BeforeCreate(tx *gorm.DB) error {
+	if order.ID == "" {
+		order.ID = uuid.NewString()
+	}
+	return nil
+}
+
+// Generating UUID for each purchase order line
+func (line *PurchaseOrderLine) BeforeCreate(tx *gorm.DB) error {
+	if line.ID == "" {
+		line.ID = uuid.NewString()
+	}
+	return nil
+}
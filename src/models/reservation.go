@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Reservation lifecycle states.
+const (
+	ReservationActive    = "active"
+	ReservationCommitted = "committed"
+	ReservationReleased  = "released"
+	ReservationExpired   = "expired"
+)
+
+// Reservation holds stock of an item until it is committed, released or expires.
+type Reservation struct {
+	ID        string     `json:"id" gorm:"type:uuid;primary_key"`
+	ItemID    string     `json:"item_id" gorm:"type:uuid;not null;index"`
+	Quantity  int        `json:"quantity" gorm:"not null"`
+	Status    string     `json:"status" gorm:"type:varchar(16);not null;index"`
+	Reference string     `json:"reference" gorm:"type:varchar(255)"`
+	ExpiresAt *time.Time `json:"expires_at" gorm:"index"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// Generating UUID for each reservation
+func (reservation *Reservation) BeforeCreate(tx *gorm.DB) error {
+	if reservation.ID == "" {
+		reservation.ID = uuid.NewString()
+	}
+	return nil
+}
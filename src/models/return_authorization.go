@@ -0,0 +1,113 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Return authorization lifecycle states.
+const (
+	ReturnAuthorized        = "authorized"
+	ReturnPartiallyReceived = "partially_received"
+	ReturnReceived          = "received"
+	ReturnCancelled         = "cancelled"
+)
+
+// Dispositions of returned units.
+const (
+	DispositionRestock    = "restock"
+	DispositionQuarantine = "quarantine"
+	DispositionScrap      = "scrap"
+)
+
+// ReturnAuthorization (RMA) authorizes a customer to send units back, optionally
+// against the sales order they were shipped on.
+type ReturnAuthorization struct {
+	ID           string              `json:"id" gorm:"type:uuid;primary_key"`
+	SalesOrderID *string             `json:"sales_order_id,omitempty" gorm:"type:uuid;index"`
+	SalesOrder   *SalesOrder         `json:"-" gorm:"constraint:OnDelete:RESTRICT"`
+	Customer     string              `json:"customer" gorm:"type:varchar(255);not null" example:"Nora Retail"`
+	Reason       string              `json:"reason" gorm:"type:text" example:"Damaged in transit"`
+	Status       string              `json:"status" gorm:"type:varchar(24);not null;index"`
+	CreatedBy    string              `json:"created_by" gorm:"type:varchar(255)"`
+	Lines        []ReturnLine        `json:"lines" gorm:"constraint:OnDelete:CASCADE"`
+	Dispositions []ReturnDisposition `json:"dispositions,omitempty" gorm:"constraint:OnDelete:CASCADE"`
+	ReceivedAt   *time.Time          `json:"received_at"`
+	CancelledAt  *time.Time          `json:"cancelled_at"`
+	CreatedAt    time.Time           `json:"created_at"`
+	UpdatedAt    time.Time           `json:"updated_at"`
+}
+
+// ReturnLine is the quantity of one item authorized for return and what has become
+// of the units received so far. Quarantined units are held apart from sellable
+// stock until they are restocked or scrapped.
+type ReturnLine struct {
+	ID                    string    `json:"id" gorm:"type:uuid;primary_key"`
+	ReturnAuthorizationID string    `json:"return_authorization_id" gorm:"type:uuid;not null;uniqueIndex:idx_return_lines_item"`
+	ItemID                string    `json:"item_id" gorm:"type:uuid;not null;uniqueIndex:idx_return_lines_item;index"`
+	Item                  *Item     `json:"item,omitempty" gorm:"constraint:OnDelete:RESTRICT"`
+	Position              int       `json:"position" gorm:"not null" example:"1"`
+	Quantity              int       `json:"quantity" gorm:"not null" example:"2"`
+	QuantityReceived      int       `json:"quantity_received" gorm:"not null;default:0"`
+	QuantityRestocked     int       `json:"quantity_restocked" gorm:"not null;default:0"`
+	QuantityQuarantined   int       `json:"quantity_quarantined" gorm:"not null;default:0"`
+	QuantityScrapped      int       `json:"quantity_scrapped" gorm:"not null;default:0"`
+	CreatedAt             time.Time `json:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
+// Outstanding returns the authorized units not yet received.
+func (line *ReturnLine) Outstanding() int {
+	return line.Quantity - line.QuantityReceived
+}
+
+// ReturnDisposition records what was done with returned units: restocked,
+// quarantined or scrapped on receipt, or later released from quarantine.
+type ReturnDisposition struct {
+	ID                    string    `json:"id" gorm:"type:uuid;primary_key"`
+	ReturnAuthorizationID string    `json:"return_authorization_id" gorm:"type:uuid;not null;index"`
+	ReturnLineID          string    `json:"return_line_id" gorm:"type:uuid;not null;index"`
+	ItemID                string    `json:"item_id" gorm:"type:uuid;not null;index"`
+	Quantity              int       `json:"quantity" gorm:"not null" example:"1"`
+	Disposition           string    `json:"disposition" gorm:"type:varchar(16);not null" example:"restock"`
+	FromQuarantine        bool      `json:"from_quarantine" gorm:"not null;default:false"`
+	LocationID            *string   `json:"location_id,omitempty" gorm:"type:uuid"`
+	Note                  string    `json:"note" gorm:"type:text" example:"Box crushed, unit intact"`
+	Actor                 string    `json:"actor" gorm:"type:varchar(255)"`
+	CreatedAt             time.Time `json:"created_at" gorm:"index"`
+}
+
+// ValidDisposition reports whether disposition is a known disposition.
+func ValidDisposition(disposition string) bool {
+	switch disposition {
+	case DispositionRestock, DispositionQuarantine, DispositionScrap:
+		return true
+	}
+	return false
+}
+
+// Generating UUID for each return authorization
+func (rma *ReturnAuthorization) BeforeCreate(tx *gorm.DB) error {
+	if rma.ID == "" {
+		rma.ID = uuid.NewString()
+	}
+	return nil
+}
+
+// Generating UUID for each return line
+func (line *ReturnLine) BeforeCreate(tx *gorm.DB) error {
+	if line.ID == "" {
+		line.ID = uuid.NewString()
+	}
+	return nil
+}
+
+// Generating UUID for each return disposition
+func (disposition *ReturnDisposition) BeforeCreate(tx *gorm.DB) error {
+	if disposition.ID == "" {
+		disposition.ID = uuid.NewString()
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Sales order lifecycle states.
+const (
+	SalesPending     = "pending"
+	SalesBackordered = "backordered"
+	SalesAllocated   = "allocated"
+	SalesPicked      = "picked"
+	SalesShipped     = "shipped"
+	SalesCancelled   = "cancelled"
+)
+
+// SalesOrder is a customer order fulfilled from stock. Allocating it holds units as
+// reserved stock; shipping removes picked units from stock.
+type SalesOrder struct {
+	ID          string           `json:"id" gorm:"type:uuid;primary_key"`
+	Customer    string           `json:"customer" gorm:"type:varchar(255);not null" example:"Nora Retail"`
+	Reference   string           `json:"reference" gorm:"type:varchar(255)" example:"SO-2024-0107"`
+	ShipTo      string           `json:"ship_to" gorm:"type:text" example:"12 King Fahd Rd, Riyadh"`
+	Status      string           `json:"status" gorm:"type:varchar(24);not null;index"`
+	CreatedBy   string           `json:"created_by" gorm:"type:varchar(255)"`
+	Lines       []SalesOrderLine `json:"lines" gorm:"constraint:OnDelete:CASCADE"`
+	ShippedAt   *time.Time       `json:"shipped_at"`
+	CancelledAt *time.Time       `json:"cancelled_at"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// SalesOrderLine is the quantity of one item ordered on a sales order. Allocated
+// units are reserved on the item until shipped; picked units are the allocated
+// units staged for the next shipment.
+type SalesOrderLine struct {
+	ID                string    `json:"id" gorm:"type:uuid;primary_key"`
+	SalesOrderID      string    `json:"sales_order_id" gorm:"type:uuid;not null;uniqueIndex:idx_sales_order_lines_item"`
+	ItemID            string    `json:"item_id" gorm:"type:uuid;not null;uniqueIndex:idx_sales_order_lines_item;index"`
+	Item              *Item     `json:"item,omitempty" gorm:"constraint:OnDelete:RESTRICT"`
+	Position          int       `json:"position" gorm:"not null" example:"1"`
+	Quantity          int       `json:"quantity" gorm:"not null" example:"3"`
+	QuantityAllocated int       `json:"quantity_allocated" gorm:"not null;default:0"`
+	QuantityPicked    int       `json:"quantity_picked" gorm:"not null;default:0"`
+	QuantityShipped   int       `json:"quantity_shipped" gorm:"not null;default:0"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// Backordered returns the units neither allocated nor shipped yet.
+func (line *SalesOrderLine) Backordered() int {
+	return line.Quantity - line.QuantityAllocated - line.QuantityShipped
+}
+
+// Generating UUID for each sales order
+func (order *SalesOrder) BeforeCreate(tx *gorm.DB) error {
+	if order.ID == "" {
+		order.ID = uuid.NewString()
+	}
+	return nil
+}
+
+// Generating UUID for each sales order line
+func (line *SalesOrderLine) BeforeCreate(tx *gorm.DB) error {
+	if line.ID == "" {
+		line.ID = uuid.NewString()
+	}
+	return nil
+}
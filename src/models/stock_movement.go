@@ -0,0 +1,71 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Reason codes recorded on stock movements.
+const (
+	MovementReceipt     = "receipt"
+	MovementSale        = "sale"
+	MovementAdjustment  = "adjustment"
+	MovementReturn      = "return"
+	MovementTransferOut = "transfer_out"
+	MovementTransferIn  = "transfer_in"
+)
+
+// StockMovement is an append-only ledger entry explaining a change to an item's stock.
+type StockMovement struct {
+	ID           string    `json:"id" gorm:"type:uuid;primary_key"`
+	ItemID       string    `json:"item_id" gorm:"type:uuid;not null;index"`
+	LocationID   *string   `json:"location_id,omitempty" gorm:"type:uuid;index"`
+	Delta        int       `json:"delta" gorm:"not null"`
+	Reason       string    `json:"reason" gorm:"type:varchar(32);not null"`
+	Actor        string    `json:"actor" gorm:"type:varchar(255)"`
+	Reference    string    `json:"reference" gorm:"type:varchar(255)"`
+	BalanceAfter int       `json:"balance_after" gorm:"not null"`
+	CreatedAt    time.Time `json:"created_at" gorm:"index"`
+}
+
+// ValidMovementReason reports whether reason is a known movement reason code.
+func ValidMovementReason(reason string) bool {
+	switch reason {
+	case MovementReceipt, MovementSale, MovementAdjustment, MovementReturn,
+		MovementTransferOut, MovementTransferIn:
+		return true
+	}
+	return false
+}
+
+// MovementSign is the sign a movement's delta must have for reason: 1 for stock
+// coming in, -1 for stock going out and 0 for adjustments, which go either way.
+func MovementSign(reason string) int {
+	switch reason {
+	case MovementReceipt, MovementReturn, MovementTransferIn:
+		return 1
+	case MovementSale, MovementTransferOut:
+		return -1
+	}
+	return 0
+}
+
+// ManualMovementReason reports whether reason may be recorded directly through the
+// movements API; transfer movements are only written by transfer orders.
+func ManualMovementReason(reason string) bool {
+	switch reason {
+	case MovementReceipt, MovementSale, MovementAdjustment, MovementReturn:
+		return true
+	}
+	return false
+}
+
+// Generating UUID for each movement
+func (movement *StockMovement) BeforeCreate(tx *gorm.DB) error {
+	if movement.ID == "" {
+		movement.ID = uuid.NewString()
+	}
+	return nil
+}
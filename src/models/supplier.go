@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// Supplier is a vendor items are bought from.
+type Supplier struct {
+	ID           string    `json:"id" gorm:"type:uuid;primary_key"`
+	Name         string    `json:"name" gorm:"type:varchar(255);not null;uniqueIndex" example:"Acme Components"`
+	ContactName  string    `json:"contact_name" gorm:"type:varchar(255)" example:"Sara Ali"`
+	Email        string    `json:"email" gorm:"type:varchar(255)" example:"orders@acme.example"`
+	Phone        string    `json:"phone" gorm:"type:varchar(64)" example:"+966 11 000 0000"`
+	Address      string    `json:"address" gorm:"type:text" example:"7 Industrial Rd, Riyadh"`
+	LeadTimeDays int       `json:"lead_time_days" gorm:"not null;default:0" example:"14"`
+	Currency     string    `json:"currency" gorm:"type:char(3);not null;default:'USD'" example:"USD"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// ItemSupplier links an item to a supplier that sells it, with the supplier's own
+// SKU, unit cost (in the supplier's currency) and minimum order quantity. At most
+// one supplier per item is preferred.
+type ItemSupplier struct {
+	ItemID           string          `json:"item_id" gorm:"type:uuid;primaryKey;uniqueIndex:idx_item_suppliers_preferred,where:preferred"`
+	SupplierID       string          `json:"supplier_id" gorm:"type:uuid;primaryKey;index"`
+	SupplierSKU      string          `json:"supplier_sku" gorm:"type:varchar(64)" example:"AC-LP-1001"`
+	UnitCost         decimal.Decimal `json:"unit_cost" gorm:"type:numeric(19,4);not null" swaggertype:"string" example:"812.50"`
+	MinOrderQuantity int             `json:"min_order_quantity" gorm:"not null;default:1" example:"5"`
+	Preferred        bool            `json:"preferred" gorm:"not null;default:false"`
+	Supplier         *Supplier       `json:"supplier,omitempty" gorm:"constraint:OnDelete:RESTRICT"`
+	Item             *Item           `json:"item,omitempty"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+}
+
+// Generating UUID for each supplier
+func (supplier *Supplier) BeforeCreate(tx *gorm.DB) error {
+	if supplier.ID == "" {
+		supplier.ID = uuid.NewString()
+	}
+	return nil
+}
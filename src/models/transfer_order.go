@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Transfer order lifecycle states.
+const (
+	TransferInTransit         = "in_transit"
+	TransferPartiallyReceived = "partially_received"
+	TransferReceived          = "received"
+	TransferCancelled         = "cancelled"
+)
+
+// TransferOrder moves units of an item from one location to another. Shipped units
+// leave the source immediately and are held as in-transit until received at the
+// destination or returned to the source on cancellation.
+type TransferOrder struct {
+	ID               string     `json:"id" gorm:"type:uuid;primary_key"`
+	ItemID           string     `json:"item_id" gorm:"type:uuid;not null;index"`
+	FromLocationID   string     `json:"from_location_id" gorm:"type:uuid;not null"`
+	ToLocationID     string     `json:"to_location_id" gorm:"type:uuid;not null"`
+	Quantity         int        `json:"quantity" gorm:"not null"`
+	QuantityReceived int        `json:"quantity_received" gorm:"not null;default:0"`
+	QuantityReturned int        `json:"quantity_returned" gorm:"not null;default:0"`
+	Status           string     `json:"status" gorm:"type:varchar(24);not null;index"`
+	Reference        string     `json:"reference" gorm:"type:varchar(255)"`
+	CreatedBy        string     `json:"created_by" gorm:"type:varchar(255)"`
+	ReceivedAt       *time.Time `json:"received_at"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// Outstanding returns the units still in transit.
+func (transfer *TransferOrder) Outstanding() int {
+	return transfer.Quantity - transfer.QuantityReceived - transfer.QuantityReturned
+}
+
+// Generating UUID for each transfer order
+func (transfer *TransferOrder) BeforeCreate(tx *gorm.DB) error {
+	if transfer.ID == "" {
+		transfer.ID = uuid.NewString()
+	}
+	return nil
+}
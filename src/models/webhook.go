@@ -0,0 +1,63 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Webhook delivery statuses.
+const (
+	WebhookPending    = "pending"
+	WebhookDelivered  = "delivered"
+	WebhookDeadLetter = "dead_letter"
+)
+
+// WebhookSubscription receives the domain events named in Events as signed POSTs
+// to URL.
+type WebhookSubscription struct {
+	ID         string            `json:"id" gorm:"type:uuid;primary_key"`
+	URL        string            `json:"url" gorm:"type:text;not null" example:"https://partner.example.com/hooks/inventory"`
+	Secret     string            `json:"-" gorm:"type:varchar(255);not null"`
+	Events     StringList        `json:"events" gorm:"not null;default:'[]'" swaggertype:"array,string" example:"item.created,item.deleted"`
+	Enabled    bool              `json:"enabled" gorm:"not null"`
+	Deliveries []WebhookDelivery `json:"-" gorm:"foreignKey:SubscriptionID;constraint:OnDelete:CASCADE"`
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+}
+
+// WebhookDelivery is one event to be POSTed to a subscription. Failed attempts are
+// retried with exponential backoff until the delivery succeeds or is dead-lettered.
+type WebhookDelivery struct {
+	ID             string     `json:"id" gorm:"type:uuid;primary_key"`
+	SubscriptionID string     `json:"subscription_id" gorm:"type:uuid;not null;index"`
+	EventID        string     `json:"event_id" gorm:"type:uuid;not null;index"`
+	EventType      string     `json:"event_type" gorm:"type:varchar(64);not null" example:"item.updated"`
+	Payload        JSON       `json:"payload" gorm:"not null" swaggertype:"object"`
+	Status         string     `json:"status" gorm:"type:varchar(16);not null;index" example:"pending"`
+	Attempts       int        `json:"attempts" gorm:"not null" example:"2"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at" gorm:"index"`
+	LastAttemptAt  *time.Time `json:"last_attempt_at"`
+	ResponseStatus int        `json:"response_status,omitempty" example:"503"`
+	LastError      string     `json:"last_error,omitempty" gorm:"type:text"`
+	DeliveredAt    *time.Time `json:"delivered_at"`
+	CreatedAt      time.Time  `json:"created_at" gorm:"index"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// Generating UUID for each webhook subscription
+func (subscription *WebhookSubscription) BeforeCreate(tx *gorm.DB) error {
+	if subscription.ID == "" {
+		subscription.ID = uuid.NewString()
+	}
+	return nil
+}
+
+// Generating UUID for each webhook delivery
+func (delivery *WebhookDelivery) BeforeCreate(tx *gorm.DB) error {
+	if delivery.ID == "" {
+		delivery.ID = uuid.NewString()
+	}
+	return nil
+}
@@ -0,0 +1,6 @@
+// Package pb holds the Go code generated from the protobuf definitions in proto/.
+// Regenerate it after changing them with go generate ./src/pb, which needs protoc,
+// protoc-gen-go and protoc-gen-go-grpc on the PATH.
+package pb
+
+//go:generate protoc -I ../../proto --go_out=../.. --go_opt=module=inventory-service --go-grpc_out=../.. --go-grpc_opt=module=inventory-service inventory/v1/inventory.proto
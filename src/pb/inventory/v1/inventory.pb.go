@@ -0,0 +1,1441 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: inventory/v1/inventory.proto
+
+package inventoryv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Item struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku               string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Barcode           *string                `protobuf:"bytes,3,opt,name=barcode,proto3,oneof" json:"barcode,omitempty"`
+	Name              string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	CategoryId        *string                `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Tags              []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	Attributes        *structpb.Struct       `protobuf:"bytes,7,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	AttributeSchemaId *string                `protobuf:"bytes,8,opt,name=attribute_schema_id,json=attributeSchemaId,proto3,oneof" json:"attribute_schema_id,omitempty"`
+	Stock             int32                  `protobuf:"varint,9,opt,name=stock,proto3" json:"stock,omitempty"`
+	Reserved          int32                  `protobuf:"varint,10,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	InTransit         int32                  `protobuf:"varint,11,opt,name=in_transit,json=inTransit,proto3" json:"in_transit,omitempty"`
+	Quarantined       int32                  `protobuf:"varint,12,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
+	OnHand            int32                  `protobuf:"varint,13,opt,name=on_hand,json=onHand,proto3" json:"on_hand,omitempty"`
+	Available         int32                  `protobuf:"varint,14,opt,name=available,proto3" json:"available,omitempty"`
+	ReorderPoint      int32                  `protobuf:"varint,15,opt,name=reorder_point,json=reorderPoint,proto3" json:"reorder_point,omitempty"`
+	ReorderQuantity   int32                  `protobuf:"varint,16,opt,name=reorder_quantity,json=reorderQuantity,proto3" json:"reorder_quantity,omitempty"`
+	SafetyStock       int32                  `protobuf:"varint,17,opt,name=safety_stock,json=safetyStock,proto3" json:"safety_stock,omitempty"`
+	// Decimal amount, e.g. "999.99"
+	Price         string                 `protobuf:"bytes,18,opt,name=price,proto3" json:"price,omitempty"`
+	Currency      string                 `protobuf:"bytes,19,opt,name=currency,proto3" json:"currency,omitempty"`
+	Version       int32                  `protobuf:"varint,20,opt,name=version,proto3" json:"version,omitempty"`
+	Locations     []*ItemStock           `protobuf:"bytes,21,rep,name=locations,proto3" json:"locations,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Item) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Item) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Item) GetBarcode() string {
+	if x != nil && x.Barcode != nil {
+		return *x.Barcode
+	}
+	return ""
+}
+
+func (x *Item) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Item) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *Item) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Item) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Item) GetAttributeSchemaId() string {
+	if x != nil && x.AttributeSchemaId != nil {
+		return *x.AttributeSchemaId
+	}
+	return ""
+}
+
+func (x *Item) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *Item) GetReserved() int32 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *Item) GetInTransit() int32 {
+	if x != nil {
+		return x.InTransit
+	}
+	return 0
+}
+
+func (x *Item) GetQuarantined() int32 {
+	if x != nil {
+		return x.Quarantined
+	}
+	return 0
+}
+
+func (x *Item) GetOnHand() int32 {
+	if x != nil {
+		return x.OnHand
+	}
+	return 0
+}
+
+func (x *Item) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *Item) GetReorderPoint() int32 {
+	if x != nil {
+		return x.ReorderPoint
+	}
+	return 0
+}
+
+func (x *Item) GetReorderQuantity() int32 {
+	if x != nil {
+		return x.ReorderQuantity
+	}
+	return 0
+}
+
+func (x *Item) GetSafetyStock() int32 {
+	if x != nil {
+		return x.SafetyStock
+	}
+	return 0
+}
+
+func (x *Item) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *Item) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Item) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Item) GetLocations() []*ItemStock {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *Item) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Item) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ItemStock is the quantity of an item held at one location.
+type ItemStock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LocationId    string                 `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	LocationCode  string                 `protobuf:"bytes,2,opt,name=location_code,json=locationCode,proto3" json:"location_code,omitempty"`
+	LocationName  string                 `protobuf:"bytes,3,opt,name=location_name,json=locationName,proto3" json:"location_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemStock) Reset() {
+	*x = ItemStock{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemStock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemStock) ProtoMessage() {}
+
+func (x *ItemStock) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemStock.ProtoReflect.Descriptor instead.
+func (*ItemStock) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ItemStock) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *ItemStock) GetLocationCode() string {
+	if x != nil {
+		return x.LocationCode
+	}
+	return ""
+}
+
+func (x *ItemStock) GetLocationName() string {
+	if x != nil {
+		return x.LocationName
+	}
+	return ""
+}
+
+func (x *ItemStock) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type GetItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Case-insensitive substring of the name
+	Name               string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Currency           string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	CategoryId         string `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	IncludeDescendants bool   `protobuf:"varint,4,opt,name=include_descendants,json=includeDescendants,proto3" json:"include_descendants,omitempty"`
+	// All must be present
+	Tags []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Attribute equality filters, or numeric comparisons for names suffixed with
+	// _gt, _gte, _lt or _lte
+	Attributes map[string]string      `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	AsOf       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	// Location ID or code; not combinable with as_of
+	Location string `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
+	MinStock *int32 `protobuf:"varint,9,opt,name=min_stock,json=minStock,proto3,oneof" json:"min_stock,omitempty"`
+	// Default 10, at most 100
+	Limit  int32 `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32 `protobuf:"varint,11,opt,name=offset,proto3" json:"offset,omitempty"`
+	// name, stock, price or created_at
+	SortBy string `protobuf:"bytes,12,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// asc or desc
+	Order         string `protobuf:"bytes,13,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemsRequest) Reset() {
+	*x = GetItemsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemsRequest) ProtoMessage() {}
+
+func (x *GetItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemsRequest.ProtoReflect.Descriptor instead.
+func (*GetItemsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetItemsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetItemsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetItemsRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *GetItemsRequest) GetIncludeDescendants() bool {
+	if x != nil {
+		return x.IncludeDescendants
+	}
+	return false
+}
+
+func (x *GetItemsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetItemsRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *GetItemsRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *GetItemsRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *GetItemsRequest) GetMinStock() int32 {
+	if x != nil && x.MinStock != nil {
+		return *x.MinStock
+	}
+	return 0
+}
+
+func (x *GetItemsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetItemsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetItemsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *GetItemsRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+type GetItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemsResponse) Reset() {
+	*x = GetItemsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemsResponse) ProtoMessage() {}
+
+func (x *GetItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemsResponse.ProtoReflect.Descriptor instead.
+func (*GetItemsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetItemsResponse) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetItemByIDRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Return the item as it was at this time
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemByIDRequest) Reset() {
+	*x = GetItemByIDRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemByIDRequest) ProtoMessage() {}
+
+func (x *GetItemByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetItemByIDRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetItemByIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetItemByIDRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type CreateItemRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Sku               string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Barcode           *string                `protobuf:"bytes,2,opt,name=barcode,proto3,oneof" json:"barcode,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CategoryId        *string                `protobuf:"bytes,4,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Tags              []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Attributes        *structpb.Struct       `protobuf:"bytes,6,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	AttributeSchemaId *string                `protobuf:"bytes,7,opt,name=attribute_schema_id,json=attributeSchemaId,proto3,oneof" json:"attribute_schema_id,omitempty"`
+	Stock             int32                  `protobuf:"varint,8,opt,name=stock,proto3" json:"stock,omitempty"`
+	Price             string                 `protobuf:"bytes,9,opt,name=price,proto3" json:"price,omitempty"`
+	// ISO 4217 code, USD when empty
+	Currency        string `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	ReorderPoint    int32  `protobuf:"varint,11,opt,name=reorder_point,json=reorderPoint,proto3" json:"reorder_point,omitempty"`
+	ReorderQuantity int32  `protobuf:"varint,12,opt,name=reorder_quantity,json=reorderQuantity,proto3" json:"reorder_quantity,omitempty"`
+	SafetyStock     int32  `protobuf:"varint,13,opt,name=safety_stock,json=safetyStock,proto3" json:"safety_stock,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateItemRequest) Reset() {
+	*x = CreateItemRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateItemRequest) ProtoMessage() {}
+
+func (x *CreateItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateItemRequest.ProtoReflect.Descriptor instead.
+func (*CreateItemRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateItemRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CreateItemRequest) GetBarcode() string {
+	if x != nil && x.Barcode != nil {
+		return *x.Barcode
+	}
+	return ""
+}
+
+func (x *CreateItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateItemRequest) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreateItemRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateItemRequest) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CreateItemRequest) GetAttributeSchemaId() string {
+	if x != nil && x.AttributeSchemaId != nil {
+		return *x.AttributeSchemaId
+	}
+	return ""
+}
+
+func (x *CreateItemRequest) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *CreateItemRequest) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *CreateItemRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateItemRequest) GetReorderPoint() int32 {
+	if x != nil {
+		return x.ReorderPoint
+	}
+	return 0
+}
+
+func (x *CreateItemRequest) GetReorderQuantity() int32 {
+	if x != nil {
+		return x.ReorderQuantity
+	}
+	return 0
+}
+
+func (x *CreateItemRequest) GetSafetyStock() int32 {
+	if x != nil {
+		return x.SafetyStock
+	}
+	return 0
+}
+
+type UpdateItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Fail unless the item is still at this version
+	ExpectedVersion *int32  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	Sku             *string `protobuf:"bytes,3,opt,name=sku,proto3,oneof" json:"sku,omitempty"`
+	// Empty removes the barcode
+	Barcode *string `protobuf:"bytes,4,opt,name=barcode,proto3,oneof" json:"barcode,omitempty"`
+	Name    *string `protobuf:"bytes,5,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// Empty removes the item from its category
+	CategoryId *string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Tags       *UpdateItemRequest_Tags `protobuf:"bytes,7,opt,name=tags,proto3" json:"tags,omitempty"`
+	// Replaces all attributes
+	Attributes *structpb.Struct `protobuf:"bytes,8,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// Empty unassigns the schema
+	AttributeSchemaId *string `protobuf:"bytes,9,opt,name=attribute_schema_id,json=attributeSchemaId,proto3,oneof" json:"attribute_schema_id,omitempty"`
+	Stock             *int32  `protobuf:"varint,10,opt,name=stock,proto3,oneof" json:"stock,omitempty"`
+	Price             *string `protobuf:"bytes,11,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	Currency          *string `protobuf:"bytes,12,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	ReorderPoint      *int32  `protobuf:"varint,13,opt,name=reorder_point,json=reorderPoint,proto3,oneof" json:"reorder_point,omitempty"`
+	ReorderQuantity   *int32  `protobuf:"varint,14,opt,name=reorder_quantity,json=reorderQuantity,proto3,oneof" json:"reorder_quantity,omitempty"`
+	SafetyStock       *int32  `protobuf:"varint,15,opt,name=safety_stock,json=safetyStock,proto3,oneof" json:"safety_stock,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateItemRequest) Reset() {
+	*x = UpdateItemRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemRequest) ProtoMessage() {}
+
+func (x *UpdateItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateItemRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateItemRequest) GetSku() string {
+	if x != nil && x.Sku != nil {
+		return *x.Sku
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetBarcode() string {
+	if x != nil && x.Barcode != nil {
+		return *x.Barcode
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetTags() *UpdateItemRequest_Tags {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateItemRequest) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UpdateItemRequest) GetAttributeSchemaId() string {
+	if x != nil && x.AttributeSchemaId != nil {
+		return *x.AttributeSchemaId
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetStock() int32 {
+	if x != nil && x.Stock != nil {
+		return *x.Stock
+	}
+	return 0
+}
+
+func (x *UpdateItemRequest) GetPrice() string {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetReorderPoint() int32 {
+	if x != nil && x.ReorderPoint != nil {
+		return *x.ReorderPoint
+	}
+	return 0
+}
+
+func (x *UpdateItemRequest) GetReorderQuantity() int32 {
+	if x != nil && x.ReorderQuantity != nil {
+		return *x.ReorderQuantity
+	}
+	return 0
+}
+
+func (x *UpdateItemRequest) GetSafetyStock() int32 {
+	if x != nil && x.SafetyStock != nil {
+		return *x.SafetyStock
+	}
+	return 0
+}
+
+type DeleteItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Fail unless the item is still at this version
+	ExpectedVersion *int32 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteItemRequest) Reset() {
+	*x = DeleteItemRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteItemRequest) ProtoMessage() {}
+
+func (x *DeleteItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteItemRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteItemRequest) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type DeleteItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteItemResponse) Reset() {
+	*x = DeleteItemResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteItemResponse) ProtoMessage() {}
+
+func (x *DeleteItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteItemResponse.ProtoReflect.Descriptor instead.
+func (*DeleteItemResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+type WatchItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only events about these items
+	ItemIds []string `protobuf:"bytes,1,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	// Only events about items whose name contains this, ignoring case
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Replay the events after this position before following live ones
+	AfterPosition *int64 `protobuf:"varint,4,opt,name=after_position,json=afterPosition,proto3,oneof" json:"after_position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchItemsRequest) Reset() {
+	*x = WatchItemsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchItemsRequest) ProtoMessage() {}
+
+func (x *WatchItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchItemsRequest.ProtoReflect.Descriptor instead.
+func (*WatchItemsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WatchItemsRequest) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+func (x *WatchItemsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WatchItemsRequest) GetAfterPosition() int64 {
+	if x != nil && x.AfterPosition != nil {
+		return *x.AfterPosition
+	}
+	return 0
+}
+
+// ItemEvent is an item.created, item.updated, item.deleted, stock.changed,
+// stock.reserved or alert.* domain event.
+type ItemEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Taken when the event was written; events of concurrent writes may arrive out
+	// of sequence order
+	Sequence int64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Increases in stream order; resume with the last one received
+	Position    int64  `protobuf:"varint,10,opt,name=position,proto3" json:"position,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Type        string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	ItemId      string `protobuf:"bytes,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemVersion int32  `protobuf:"varint,5,opt,name=item_version,json=itemVersion,proto3" json:"item_version,omitempty"`
+	Actor       string `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`
+	RequestId   string `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// The event payload, as in the REST event stream
+	Data          *structpb.Struct       `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemEvent) Reset() {
+	*x = ItemEvent{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemEvent) ProtoMessage() {}
+
+func (x *ItemEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemEvent.ProtoReflect.Descriptor instead.
+func (*ItemEvent) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ItemEvent) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ItemEvent) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ItemEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ItemEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ItemEvent) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ItemEvent) GetItemVersion() int32 {
+	if x != nil {
+		return x.ItemVersion
+	}
+	return 0
+}
+
+func (x *ItemEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ItemEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ItemEvent) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ItemEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// Tags wraps the replacement tag list so that an empty list can clear them.
+type UpdateItemRequest_Tags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateItemRequest_Tags) Reset() {
+	*x = UpdateItemRequest_Tags{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateItemRequest_Tags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemRequest_Tags) ProtoMessage() {}
+
+func (x *UpdateItemRequest_Tags) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemRequest_Tags.ProtoReflect.Descriptor instead.
+func (*UpdateItemRequest_Tags) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *UpdateItemRequest_Tags) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+var File_inventory_v1_inventory_proto protoreflect.FileDescriptor
+
+const file_inventory_v1_inventory_proto_rawDesc = "" +
+	"\n" +
+	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcd\x06\n" +
+	"\x04Item\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x1d\n" +
+	"\abarcode\x18\x03 \x01(\tH\x00R\abarcode\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12$\n" +
+	"\vcategory_id\x18\x05 \x01(\tH\x01R\n" +
+	"categoryId\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x127\n" +
+	"\n" +
+	"attributes\x18\a \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributes\x123\n" +
+	"\x13attribute_schema_id\x18\b \x01(\tH\x02R\x11attributeSchemaId\x88\x01\x01\x12\x14\n" +
+	"\x05stock\x18\t \x01(\x05R\x05stock\x12\x1a\n" +
+	"\breserved\x18\n" +
+	" \x01(\x05R\breserved\x12\x1d\n" +
+	"\n" +
+	"in_transit\x18\v \x01(\x05R\tinTransit\x12 \n" +
+	"\vquarantined\x18\f \x01(\x05R\vquarantined\x12\x17\n" +
+	"\aon_hand\x18\r \x01(\x05R\x06onHand\x12\x1c\n" +
+	"\tavailable\x18\x0e \x01(\x05R\tavailable\x12#\n" +
+	"\rreorder_point\x18\x0f \x01(\x05R\freorderPoint\x12)\n" +
+	"\x10reorder_quantity\x18\x10 \x01(\x05R\x0freorderQuantity\x12!\n" +
+	"\fsafety_stock\x18\x11 \x01(\x05R\vsafetyStock\x12\x14\n" +
+	"\x05price\x18\x12 \x01(\tR\x05price\x12\x1a\n" +
+	"\bcurrency\x18\x13 \x01(\tR\bcurrency\x12\x18\n" +
+	"\aversion\x18\x14 \x01(\x05R\aversion\x125\n" +
+	"\tlocations\x18\x15 \x03(\v2\x17.inventory.v1.ItemStockR\tlocations\x129\n" +
+	"\n" +
+	"created_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\n" +
+	"\n" +
+	"\b_barcodeB\x0e\n" +
+	"\f_category_idB\x16\n" +
+	"\x14_attribute_schema_id\"\x92\x01\n" +
+	"\tItemStock\x12\x1f\n" +
+	"\vlocation_id\x18\x01 \x01(\tR\n" +
+	"locationId\x12#\n" +
+	"\rlocation_code\x18\x02 \x01(\tR\flocationCode\x12#\n" +
+	"\rlocation_name\x18\x03 \x01(\tR\flocationName\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\"\x8f\x04\n" +
+	"\x0fGetItemsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\tR\n" +
+	"categoryId\x12/\n" +
+	"\x13include_descendants\x18\x04 \x01(\bR\x12includeDescendants\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12M\n" +
+	"\n" +
+	"attributes\x18\x06 \x03(\v2-.inventory.v1.GetItemsRequest.AttributesEntryR\n" +
+	"attributes\x12/\n" +
+	"\x05as_of\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12\x1a\n" +
+	"\blocation\x18\b \x01(\tR\blocation\x12 \n" +
+	"\tmin_stock\x18\t \x01(\x05H\x00R\bminStock\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\n" +
+	" \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\v \x01(\x05R\x06offset\x12\x17\n" +
+	"\asort_by\x18\f \x01(\tR\x06sortBy\x12\x14\n" +
+	"\x05order\x18\r \x01(\tR\x05order\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\f\n" +
+	"\n" +
+	"_min_stock\"<\n" +
+	"\x10GetItemsResponse\x12(\n" +
+	"\x05items\x18\x01 \x03(\v2\x12.inventory.v1.ItemR\x05items\"U\n" +
+	"\x12GetItemByIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"\xef\x03\n" +
+	"\x11CreateItemRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1d\n" +
+	"\abarcode\x18\x02 \x01(\tH\x00R\abarcode\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12$\n" +
+	"\vcategory_id\x18\x04 \x01(\tH\x01R\n" +
+	"categoryId\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x127\n" +
+	"\n" +
+	"attributes\x18\x06 \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributes\x123\n" +
+	"\x13attribute_schema_id\x18\a \x01(\tH\x02R\x11attributeSchemaId\x88\x01\x01\x12\x14\n" +
+	"\x05stock\x18\b \x01(\x05R\x05stock\x12\x14\n" +
+	"\x05price\x18\t \x01(\tR\x05price\x12\x1a\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tR\bcurrency\x12#\n" +
+	"\rreorder_point\x18\v \x01(\x05R\freorderPoint\x12)\n" +
+	"\x10reorder_quantity\x18\f \x01(\x05R\x0freorderQuantity\x12!\n" +
+	"\fsafety_stock\x18\r \x01(\x05R\vsafetyStockB\n" +
+	"\n" +
+	"\b_barcodeB\x0e\n" +
+	"\f_category_idB\x16\n" +
+	"\x14_attribute_schema_id\"\x9c\x06\n" +
+	"\x11UpdateItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x10expected_version\x18\x02 \x01(\x05H\x00R\x0fexpectedVersion\x88\x01\x01\x12\x15\n" +
+	"\x03sku\x18\x03 \x01(\tH\x01R\x03sku\x88\x01\x01\x12\x1d\n" +
+	"\abarcode\x18\x04 \x01(\tH\x02R\abarcode\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x05 \x01(\tH\x03R\x04name\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\x06 \x01(\tH\x04R\n" +
+	"categoryId\x88\x01\x01\x128\n" +
+	"\x04tags\x18\a \x01(\v2$.inventory.v1.UpdateItemRequest.TagsR\x04tags\x127\n" +
+	"\n" +
+	"attributes\x18\b \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributes\x123\n" +
+	"\x13attribute_schema_id\x18\t \x01(\tH\x05R\x11attributeSchemaId\x88\x01\x01\x12\x19\n" +
+	"\x05stock\x18\n" +
+	" \x01(\x05H\x06R\x05stock\x88\x01\x01\x12\x19\n" +
+	"\x05price\x18\v \x01(\tH\aR\x05price\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\f \x01(\tH\bR\bcurrency\x88\x01\x01\x12(\n" +
+	"\rreorder_point\x18\r \x01(\x05H\tR\freorderPoint\x88\x01\x01\x12.\n" +
+	"\x10reorder_quantity\x18\x0e \x01(\x05H\n" +
+	"R\x0freorderQuantity\x88\x01\x01\x12&\n" +
+	"\fsafety_stock\x18\x0f \x01(\x05H\vR\vsafetyStock\x88\x01\x01\x1a\x1e\n" +
+	"\x04Tags\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06valuesB\x13\n" +
+	"\x11_expected_versionB\x06\n" +
+	"\x04_skuB\n" +
+	"\n" +
+	"\b_barcodeB\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_category_idB\x16\n" +
+	"\x14_attribute_schema_idB\b\n" +
+	"\x06_stockB\b\n" +
+	"\x06_priceB\v\n" +
+	"\t_currencyB\x10\n" +
+	"\x0e_reorder_pointB\x13\n" +
+	"\x11_reorder_quantityB\x0f\n" +
+	"\r_safety_stock\"h\n" +
+	"\x11DeleteItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x10expected_version\x18\x02 \x01(\x05H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"\x14\n" +
+	"\x12DeleteItemResponse\"\x97\x01\n" +
+	"\x11WatchItemsRequest\x12\x19\n" +
+	"\bitem_ids\x18\x01 \x03(\tR\aitemIds\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12*\n" +
+	"\x0eafter_position\x18\x04 \x01(\x03H\x00R\rafterPosition\x88\x01\x01B\x11\n" +
+	"\x0f_after_positionJ\x04\b\x03\x10\x04R\x0eafter_sequence\"\xc2\x02\n" +
+	"\tItemEvent\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12\x1a\n" +
+	"\bposition\x18\n" +
+	" \x01(\x03R\bposition\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x17\n" +
+	"\aitem_id\x18\x04 \x01(\tR\x06itemId\x12!\n" +
+	"\fitem_version\x18\x05 \x01(\x05R\vitemVersion\x12\x14\n" +
+	"\x05actor\x18\x06 \x01(\tR\x05actor\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\a \x01(\tR\trequestId\x12+\n" +
+	"\x04data\x18\b \x01(\v2\x17.google.protobuf.StructR\x04data\x12;\n" +
+	"\voccurred_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt2\xc3\x03\n" +
+	"\x10InventoryService\x12I\n" +
+	"\bGetItems\x12\x1d.inventory.v1.GetItemsRequest\x1a\x1e.inventory.v1.GetItemsResponse\x12C\n" +
+	"\vGetItemByID\x12 .inventory.v1.GetItemByIDRequest\x1a\x12.inventory.v1.Item\x12A\n" +
+	"\n" +
+	"CreateItem\x12\x1f.inventory.v1.CreateItemRequest\x1a\x12.inventory.v1.Item\x12A\n" +
+	"\n" +
+	"UpdateItem\x12\x1f.inventory.v1.UpdateItemRequest\x1a\x12.inventory.v1.Item\x12O\n" +
+	"\n" +
+	"DeleteItem\x12\x1f.inventory.v1.DeleteItemRequest\x1a .inventory.v1.DeleteItemResponse\x12H\n" +
+	"\n" +
+	"WatchItems\x12\x1f.inventory.v1.WatchItemsRequest\x1a\x17.inventory.v1.ItemEvent0\x01B3Z1inventory-service/src/pb/inventory/v1;inventoryv1b\x06proto3"
+
+var (
+	file_inventory_v1_inventory_proto_rawDescOnce sync.Once
+	file_inventory_v1_inventory_proto_rawDescData []byte
+)
+
+func file_inventory_v1_inventory_proto_rawDescGZIP() []byte {
+	file_inventory_v1_inventory_proto_rawDescOnce.Do(func() {
+		file_inventory_v1_inventory_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_inventory_v1_inventory_proto_rawDesc), len(file_inventory_v1_inventory_proto_rawDesc)))
+	})
+	return file_inventory_v1_inventory_proto_rawDescData
+}
+
+var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_inventory_v1_inventory_proto_goTypes = []any{
+	(*Item)(nil),                   // 0: inventory.v1.Item
+	(*ItemStock)(nil),              // 1: inventory.v1.ItemStock
+	(*GetItemsRequest)(nil),        // 2: inventory.v1.GetItemsRequest
+	(*GetItemsResponse)(nil),       // 3: inventory.v1.GetItemsResponse
+	(*GetItemByIDRequest)(nil),     // 4: inventory.v1.GetItemByIDRequest
+	(*CreateItemRequest)(nil),      // 5: inventory.v1.CreateItemRequest
+	(*UpdateItemRequest)(nil),      // 6: inventory.v1.UpdateItemRequest
+	(*DeleteItemRequest)(nil),      // 7: inventory.v1.DeleteItemRequest
+	(*DeleteItemResponse)(nil),     // 8: inventory.v1.DeleteItemResponse
+	(*WatchItemsRequest)(nil),      // 9: inventory.v1.WatchItemsRequest
+	(*ItemEvent)(nil),              // 10: inventory.v1.ItemEvent
+	nil,                            // 11: inventory.v1.GetItemsRequest.AttributesEntry
+	(*UpdateItemRequest_Tags)(nil), // 12: inventory.v1.UpdateItemRequest.Tags
+	(*structpb.Struct)(nil),        // 13: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),  // 14: google.protobuf.Timestamp
+}
+var file_inventory_v1_inventory_proto_depIdxs = []int32{
+	13, // 0: inventory.v1.Item.attributes:type_name -> google.protobuf.Struct
+	1,  // 1: inventory.v1.Item.locations:type_name -> inventory.v1.ItemStock
+	14, // 2: inventory.v1.Item.created_at:type_name -> google.protobuf.Timestamp
+	14, // 3: inventory.v1.Item.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 4: inventory.v1.GetItemsRequest.attributes:type_name -> inventory.v1.GetItemsRequest.AttributesEntry
+	14, // 5: inventory.v1.GetItemsRequest.as_of:type_name -> google.protobuf.Timestamp
+	0,  // 6: inventory.v1.GetItemsResponse.items:type_name -> inventory.v1.Item
+	14, // 7: inventory.v1.GetItemByIDRequest.as_of:type_name -> google.protobuf.Timestamp
+	13, // 8: inventory.v1.CreateItemRequest.attributes:type_name -> google.protobuf.Struct
+	12, // 9: inventory.v1.UpdateItemRequest.tags:type_name -> inventory.v1.UpdateItemRequest.Tags
+	13, // 10: inventory.v1.UpdateItemRequest.attributes:type_name -> google.protobuf.Struct
+	13, // 11: inventory.v1.ItemEvent.data:type_name -> google.protobuf.Struct
+	14, // 12: inventory.v1.ItemEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	2,  // 13: inventory.v1.InventoryService.GetItems:input_type -> inventory.v1.GetItemsRequest
+	4,  // 14: inventory.v1.InventoryService.GetItemByID:input_type -> inventory.v1.GetItemByIDRequest
+	5,  // 15: inventory.v1.InventoryService.CreateItem:input_type -> inventory.v1.CreateItemRequest
+	6,  // 16: inventory.v1.InventoryService.UpdateItem:input_type -> inventory.v1.UpdateItemRequest
+	7,  // 17: inventory.v1.InventoryService.DeleteItem:input_type -> inventory.v1.DeleteItemRequest
+	9,  // 18: inventory.v1.InventoryService.WatchItems:input_type -> inventory.v1.WatchItemsRequest
+	3,  // 19: inventory.v1.InventoryService.GetItems:output_type -> inventory.v1.GetItemsResponse
+	0,  // 20: inventory.v1.InventoryService.GetItemByID:output_type -> inventory.v1.Item
+	0,  // 21: inventory.v1.InventoryService.CreateItem:output_type -> inventory.v1.Item
+	0,  // 22: inventory.v1.InventoryService.UpdateItem:output_type -> inventory.v1.Item
+	8,  // 23: inventory.v1.InventoryService.DeleteItem:output_type -> inventory.v1.DeleteItemResponse
+	10, // 24: inventory.v1.InventoryService.WatchItems:output_type -> inventory.v1.ItemEvent
+	19, // [19:25] is the sub-list for method output_type
+	13, // [13:19] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_inventory_v1_inventory_proto_init() }
+func file_inventory_v1_inventory_proto_init() {
+	if File_inventory_v1_inventory_proto != nil {
+		return
+	}
+	file_inventory_v1_inventory_proto_msgTypes[0].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[2].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[5].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[6].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[7].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[9].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_v1_inventory_proto_rawDesc), len(file_inventory_v1_inventory_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_inventory_v1_inventory_proto_goTypes,
+		DependencyIndexes: file_inventory_v1_inventory_proto_depIdxs,
+		MessageInfos:      file_inventory_v1_inventory_proto_msgTypes,
+	}.Build()
+	File_inventory_v1_inventory_proto = out.File
+	file_inventory_v1_inventory_proto_goTypes = nil
+	file_inventory_v1_inventory_proto_depIdxs = nil
+}
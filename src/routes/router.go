@@ -4,16 +4,140 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"inventory-service/src/controllers"
+	"inventory-service/src/middlewares"
 )
 
-// Grouping all routes under the /inventory path
+// Grouping routes by resource: /inventory, /locations, /categories, /attribute-schemas,
+// /suppliers, /purchase-orders, /sales-orders, /returns, /alerts, /webhooks and /audit
 func RegisterRoutes(router *gin.Engine) {
 	inventory := router.Group("/inventory")
 	{
 		inventory.GET("", controllers.GetItems)
 		inventory.POST("", controllers.CreateItem)
+		inventory.GET("/by-sku/:sku", controllers.GetItemBySKU)
+		inventory.GET("/by-barcode/:code", controllers.GetItemByBarcode)
+		inventory.GET("/labels", controllers.GetLabels)
+		inventory.GET("/replenishment", controllers.GetReplenishment)
+		inventory.GET("/stream", controllers.StreamItems)
+		inventory.GET("/watch", controllers.WatchItems)
+		inventory.GET("/trash", controllers.GetTrash)
+		inventory.DELETE("/trash/:id", middlewares.RequireAdmin(), controllers.PurgeItem)
 		inventory.GET("/:id", controllers.GetItemByID)
 		inventory.PUT("/:id", controllers.UpdateItem)
 		inventory.DELETE("/:id", controllers.DeleteItem)
+		inventory.POST("/:id/restore", controllers.RestoreItem)
+		inventory.GET("/:id/history", controllers.GetItemHistory)
+		inventory.GET("/:id/label", controllers.GetItemLabel)
+		inventory.GET("/:id/movements", controllers.GetMovements)
+		inventory.POST("/:id/movements", controllers.CreateMovement)
+		inventory.GET("/:id/reservations", controllers.GetReservations)
+		inventory.POST("/:id/reservations", controllers.CreateReservation)
+		inventory.POST("/:id/reservations/:reservation_id/commit", controllers.CommitReservation)
+		inventory.POST("/:id/reservations/:reservation_id/release", controllers.ReleaseReservation)
+		inventory.GET("/:id/suppliers", controllers.GetItemSuppliers)
+		inventory.GET("/:id/suppliers/preferred", controllers.GetItemPreferredSupplier)
+		inventory.PUT("/:id/suppliers/:supplier_id", controllers.SetItemSupplier)
+		inventory.DELETE("/:id/suppliers/:supplier_id", controllers.RemoveItemSupplier)
+		inventory.GET("/:id/transfers", controllers.GetTransfers)
+		inventory.POST("/:id/transfers", controllers.CreateTransfer)
+		inventory.GET("/:id/transfers/:transfer_id", controllers.GetTransferByID)
+		inventory.POST("/:id/transfers/:transfer_id/receive", controllers.ReceiveTransfer)
+		inventory.POST("/:id/transfers/:transfer_id/cancel", controllers.CancelTransfer)
 	}
+
+	locations := router.Group("/locations")
+	{
+		locations.GET("", controllers.GetLocations)
+		locations.POST("", controllers.CreateLocation)
+		locations.GET("/:id", controllers.GetLocationByID)
+		locations.PUT("/:id", controllers.UpdateLocation)
+		locations.DELETE("/:id", controllers.DeleteLocation)
+		locations.GET("/:id/stock", controllers.GetLocationStock)
+	}
+
+	categories := router.Group("/categories")
+	{
+		categories.GET("", controllers.GetCategories)
+		categories.POST("", controllers.CreateCategory)
+		categories.GET("/rollup", controllers.GetCategoryRollup)
+		categories.GET("/:id", controllers.GetCategoryByID)
+		categories.PUT("/:id", controllers.UpdateCategory)
+		categories.DELETE("/:id", controllers.DeleteCategory)
+		categories.GET("/:id/rollup", controllers.GetCategorySubtreeRollup)
+	}
+
+	schemas := router.Group("/attribute-schemas")
+	{
+		schemas.GET("", controllers.GetAttributeSchemas)
+		schemas.POST("", controllers.CreateAttributeSchema)
+		schemas.GET("/:id", controllers.GetAttributeSchemaByID)
+		schemas.PUT("/:id", controllers.UpdateAttributeSchema)
+		schemas.DELETE("/:id", controllers.DeleteAttributeSchema)
+	}
+
+	suppliers := router.Group("/suppliers")
+	{
+		suppliers.GET("", controllers.GetSuppliers)
+		suppliers.POST("", controllers.CreateSupplier)
+		suppliers.GET("/preferred", controllers.GetPreferredSuppliers)
+		suppliers.GET("/:id", controllers.GetSupplierByID)
+		suppliers.PUT("/:id", controllers.UpdateSupplier)
+		suppliers.DELETE("/:id", controllers.DeleteSupplier)
+		suppliers.GET("/:id/items", controllers.GetSupplierItems)
+	}
+
+	purchaseOrders := router.Group("/purchase-orders")
+	{
+		purchaseOrders.GET("", controllers.GetPurchaseOrders)
+		purchaseOrders.POST("", controllers.CreatePurchaseOrder)
+		purchaseOrders.GET("/:id", controllers.GetPurchaseOrderByID)
+		purchaseOrders.PUT("/:id", controllers.UpdatePurchaseOrder)
+		purchaseOrders.DELETE("/:id", controllers.DeletePurchaseOrder)
+		purchaseOrders.POST("/:id/send", controllers.SendPurchaseOrder)
+		purchaseOrders.POST("/:id/cancel", controllers.CancelPurchaseOrder)
+		purchaseOrders.POST("/:id/receive", controllers.ReceivePurchaseOrder)
+	}
+
+	salesOrders := router.Group("/sales-orders")
+	{
+		salesOrders.GET("", controllers.GetSalesOrders)
+		salesOrders.POST("", controllers.CreateSalesOrder)
+		salesOrders.GET("/:id", controllers.GetSalesOrderByID)
+		salesOrders.POST("/:id/allocate", controllers.AllocateSalesOrder)
+		salesOrders.POST("/:id/pick", controllers.PickSalesOrder)
+		salesOrders.POST("/:id/ship", controllers.ShipSalesOrder)
+		salesOrders.POST("/:id/cancel", controllers.CancelSalesOrder)
+	}
+
+	returns := router.Group("/returns")
+	{
+		returns.GET("", controllers.GetReturns)
+		returns.POST("", controllers.CreateReturn)
+		returns.GET("/:id", controllers.GetReturnByID)
+		returns.POST("/:id/receive", controllers.ReceiveReturn)
+		returns.POST("/:id/release", controllers.ReleaseQuarantine)
+		returns.POST("/:id/cancel", controllers.CancelReturn)
+	}
+
+	alerts := router.Group("/alerts")
+	{
+		alerts.GET("", controllers.GetAlerts)
+		alerts.GET("/rules", controllers.GetAlertRules)
+		alerts.POST("/rules", controllers.CreateAlertRule)
+		alerts.PUT("/rules/:id", controllers.UpdateAlertRule)
+		alerts.DELETE("/rules/:id", controllers.DeleteAlertRule)
+	}
+
+	webhooks := router.Group("/webhooks")
+	{
+		webhooks.GET("", controllers.GetWebhooks)
+		webhooks.POST("", controllers.CreateWebhook)
+		webhooks.GET("/:id", controllers.GetWebhookByID)
+		webhooks.PUT("/:id", controllers.UpdateWebhook)
+		webhooks.DELETE("/:id", controllers.DeleteWebhook)
+		webhooks.GET("/:id/deliveries", controllers.GetWebhookDeliveries)
+		webhooks.POST("/:id/deliveries/:delivery_id/redeliver", controllers.RedeliverWebhook)
+	}
+
+	router.GET("/audit", controllers.SearchAudit)
 }
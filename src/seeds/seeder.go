@@ -3,6 +3,7 @@ package seeds
 import (
 	"log"
 
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 
 	"inventory-service/src/models"
@@ -21,11 +22,11 @@ func SeedDatabase(db *gorm.DB) error {
 	}
 
 	items := []models.Item{
-		{Name: "Laptop", Stock: 10, Price: 999.99},
-		{Name: "Smartphone", Stock: 25, Price: 699.99},
-		{Name: "Headphones", Stock: 15, Price: 199.99},
-		{Name: "Keyboard", Stock: 30, Price: 89.99},
-		{Name: "Monitor", Stock: 12, Price: 299.99},
+		{SKU: "LAPTOP-001", Name: "Laptop", Stock: 10, Price: decimal.RequireFromString("999.99"), Currency: models.DefaultCurrency},
+		{SKU: "PHONE-001", Name: "Smartphone", Stock: 25, Price: decimal.RequireFromString("699.99"), Currency: models.DefaultCurrency},
+		{SKU: "AUDIO-001", Name: "Headphones", Stock: 15, Price: decimal.RequireFromString("199.99"), Currency: models.DefaultCurrency},
+		{SKU: "KEYB-001", Name: "Keyboard", Stock: 30, Price: decimal.RequireFromString("89.99"), Currency: models.DefaultCurrency},
+		{SKU: "MON-001", Name: "Monitor", Stock: 12, Price: decimal.RequireFromString("299.99"), Currency: models.DefaultCurrency},
 	}
 
 	if err := db.Create(&items).Error; err != nil {
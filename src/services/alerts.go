@@ -0,0 +1,176 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"inventory-service/src/models"
+)
+
+var (
+	ErrAlertRuleNotFound = errors.New("alert rule not found")
+	ErrInvalidAlertRule  = errors.New("invalid alert rule")
+)
+
+// StockAlert is the data of an alert.low_stock, alert.out_of_stock or
+// alert.recovered event.
+type StockAlert struct {
+	Alert models.Alert `json:"alert"`
+	Item  AlertItem    `json:"item"`
+}
+
+// AlertItem identifies the item an alert is about.
+type AlertItem struct {
+	ID   string `json:"id"`
+	SKU  string `json:"sku"`
+	Name string `json:"name"`
+}
+
+// SaveAlertRule creates or updates a rule after checking its threshold and item.
+// The level of every item the rule applies to is recorded without alerting, so
+// items alert on the next change of level rather than on their next movement.
+func SaveAlertRule(tx *gorm.DB, rule *models.AlertRule) error {
+	if rule.Threshold < 0 {
+		return fmt.Errorf("%w: threshold must not be negative", ErrInvalidAlertRule)
+	}
+	if rule.ItemID != nil {
+		var item models.Item
+		if err := tx.First(&item, "id = ?", *rule.ItemID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w: %s", ErrItemNotFound, *rule.ItemID)
+			}
+			return err
+		}
+	}
+
+	if rule.ID == "" {
+		if err := tx.Create(rule).Error; err != nil {
+			return err
+		}
+		return initAlertStates(tx, rule)
+	}
+	// A changed rule starts over from the items it now applies to
+	if err := tx.Where("rule_id = ?", rule.ID).Delete(&models.AlertState{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Save(rule).Error; err != nil {
+		return err
+	}
+	return initAlertStates(tx, rule)
+}
+
+// DeleteAlertRule removes a rule. Alerts it fired are kept.
+func DeleteAlertRule(tx *gorm.DB, id string) error {
+	result := tx.Delete(&models.AlertRule{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrAlertRuleNotFound
+	}
+	return tx.Where("rule_id = ?", id).Delete(&models.AlertState{}).Error
+}
+
+// EvaluateAlerts checks an item's available stock against every enabled rule that
+// applies to it. A rule fires low_stock when the item's level drops to low,
+// out_of_stock when it drops to out and recovered when it is back to ok; changes
+// within a level record nothing. Every alert is emitted as an alert.<event> domain
+// event, which webhook subscriptions deliver. It runs inside the transaction that
+// changed the stock or its holds, so alerts commit with the change.
+func EvaluateAlerts(tx *gorm.DB, audit AuditContext, item *models.Item) error {
+	var rules []models.AlertRule
+	err := tx.Where("enabled AND (item_id IS NULL OR item_id = ?)", item.ID).Find(&rules).Error
+	if err != nil || len(rules) == 0 {
+		return err
+	}
+
+	available := item.Stock - item.Reserved
+	for _, rule := range rules {
+		state := models.AlertState{Level: models.AlertLevelOK}
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("rule_id = ? AND item_id = ?", rule.ID, item.ID).
+			Limit(1).Find(&state).Error
+		if err != nil {
+			return err
+		}
+
+		level := alertLevel(available, rule.Threshold)
+		if level == state.Level {
+			continue
+		}
+
+		alert := models.Alert{
+			RuleID:    rule.ID,
+			ItemID:    item.ID,
+			Event:     models.AlertRecovered,
+			Threshold: rule.Threshold,
+			Stock:     item.Stock,
+			Available: available,
+		}
+		switch level {
+		case models.AlertLevelLow:
+			alert.Event = models.AlertLowStock
+		case models.AlertLevelOut:
+			alert.Event = models.AlertOutOfStock
+		}
+		if err := tx.Create(&alert).Error; err != nil {
+			return err
+		}
+		data := StockAlert{Alert: alert, Item: AlertItem{ID: item.ID, SKU: item.SKU, Name: item.Name}}
+		if err := RecordItemEvent(tx, audit, "alert."+alert.Event, item, data); err != nil {
+			return err
+		}
+
+		state = models.AlertState{RuleID: rule.ID, ItemID: item.ID, Level: level}
+		err = tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "rule_id"}, {Name: "item_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"level", "updated_at"}),
+		}).Create(&state).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BackfillAlertLevels converts alert states written before levels existed: a
+// triggered state becomes low, so an item already at zero still alerts
+// out_of_stock on its next change.
+func BackfillAlertLevels(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&models.AlertState{}, "triggered") {
+		return nil
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("UPDATE alert_states SET level = ? WHERE triggered", models.AlertLevelLow).Error
+		if err != nil {
+			return err
+		}
+		return tx.Migrator().DropColumn(&models.AlertState{}, "triggered")
+	})
+}
+
+// alertLevel is the level of available stock under a rule's threshold.
+func alertLevel(available, threshold int) string {
+	switch {
+	case available <= 0:
+		return models.AlertLevelOut
+	case available <= threshold:
+		return models.AlertLevelLow
+	}
+	return models.AlertLevelOK
+}
+
+// initAlertStates records the current level of every item rule applies to.
+func initAlertStates(tx *gorm.DB, rule *models.AlertRule) error {
+	items := tx.Model(&models.Item{}).
+		Select("?::uuid AS rule_id, id AS item_id, CASE WHEN stock - reserved <= 0 THEN ? WHEN stock - reserved <= ? THEN ? ELSE ? END AS level, now() AS updated_at",
+			rule.ID, models.AlertLevelOut, rule.Threshold, models.AlertLevelLow, models.AlertLevelOK)
+	if rule.ItemID != nil {
+		items = items.Where("id = ?", *rule.ItemID)
+	}
+	return tx.Exec(`INSERT INTO alert_states (rule_id, item_id, level, updated_at) ?
+		ON CONFLICT (rule_id, item_id) DO UPDATE SET level = EXCLUDED.level, updated_at = EXCLUDED.updated_at`, items).Error
+}
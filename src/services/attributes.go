@@ -0,0 +1,213 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+)
+
+var (
+	ErrInvalidAttributes       = errors.New("invalid attributes")
+	ErrAttributeSchemaNotFound = errors.New("attribute schema not found")
+	ErrAttributeSchemaInUse    = errors.New("attribute schema is assigned to items")
+)
+
+// AttributeNamePattern is the form attribute names take, so they are safe to
+// use as filter keys such as attr.color or attr.weight_gt.
+var AttributeNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+const maxTags = 32
+
+// NormalizeTags lower-cases, trims and de-duplicates tags and sorts them.
+func NormalizeTags(tags []string) (models.StringList, error) {
+	seen := map[string]bool{}
+	normalized := models.StringList{}
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || len(tag) > 64 {
+			return nil, fmt.Errorf("%w: tags must be 1-64 characters", ErrInvalidAttributes)
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			normalized = append(normalized, tag)
+		}
+	}
+	if len(normalized) > maxTags {
+		return nil, fmt.Errorf("%w: at most %d tags", ErrInvalidAttributes, maxTags)
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
+// ValidateItemAttributes checks an item's custom attributes: always that they are
+// flat name/value pairs, and against its attribute schema when it has one.
+func ValidateItemAttributes(tx *gorm.DB, item *models.Item) error {
+	for name, value := range item.Attributes {
+		if !AttributeNamePattern.MatchString(name) {
+			return fmt.Errorf("%w: attribute name %q must be lower-case letters, digits and underscores", ErrInvalidAttributes, name)
+		}
+		switch value.(type) {
+		case string, float64, bool:
+		default:
+			return fmt.Errorf("%w: attribute %q must be a string, number or boolean", ErrInvalidAttributes, name)
+		}
+	}
+
+	if item.AttributeSchemaID == nil {
+		return nil
+	}
+	var schema models.AttributeSchema
+	if err := tx.First(&schema, "id = ?", *item.AttributeSchemaID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%w: %s", ErrAttributeSchemaNotFound, *item.AttributeSchemaID)
+		}
+		return err
+	}
+	return checkAgainstSchema(schema.Fields, item.Attributes)
+}
+
+// SaveAttributeSchema creates or updates a schema after checking its fields. A
+// changed schema must still accept the attributes of every item assigned to it.
+func SaveAttributeSchema(tx *gorm.DB, schema *models.AttributeSchema) error {
+	if err := validateFields(schema.Fields); err != nil {
+		return err
+	}
+
+	if schema.ID == "" {
+		return tx.Create(schema).Error
+	}
+
+	var items []models.Item
+	if err := tx.Where("attribute_schema_id = ?", schema.ID).Find(&items).Error; err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := checkAgainstSchema(schema.Fields, item.Attributes); err != nil {
+			return fmt.Errorf("%w (item %s)", err, item.SKU)
+		}
+	}
+	return tx.Save(schema).Error
+}
+
+// DeleteAttributeSchema removes a schema no item is assigned to, counting items
+// in the trash since they may still be restored.
+func DeleteAttributeSchema(tx *gorm.DB, id string) error {
+	var schema models.AttributeSchema
+	if err := tx.First(&schema, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrAttributeSchemaNotFound
+		}
+		return err
+	}
+
+	var count int64
+	if err := tx.Unscoped().Model(&models.Item{}).Where("attribute_schema_id = ?", id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrAttributeSchemaInUse
+	}
+	return tx.Delete(&schema).Error
+}
+
+func validateFields(fields models.AttributeFields) error {
+	seen := map[string]bool{}
+	for _, field := range fields {
+		if !AttributeNamePattern.MatchString(field.Name) {
+			return fmt.Errorf("%w: field name %q must be lower-case letters, digits and underscores", ErrInvalidAttributes, field.Name)
+		}
+		if seen[field.Name] {
+			return fmt.Errorf("%w: field %q is declared twice", ErrInvalidAttributes, field.Name)
+		}
+		seen[field.Name] = true
+
+		switch field.Type {
+		case models.AttributeString, models.AttributeNumber, models.AttributeInteger, models.AttributeBoolean:
+		case models.AttributeEnum:
+			if len(field.Values) == 0 {
+				return fmt.Errorf("%w: enum field %q needs values", ErrInvalidAttributes, field.Name)
+			}
+		default:
+			return fmt.Errorf("%w: field %q has unknown type %q", ErrInvalidAttributes, field.Name, field.Type)
+		}
+		if field.Min != nil && field.Max != nil && *field.Min > *field.Max {
+			return fmt.Errorf("%w: field %q has min above max", ErrInvalidAttributes, field.Name)
+		}
+	}
+	return nil
+}
+
+func checkAgainstSchema(fields models.AttributeFields, attributes models.Attributes) error {
+	declared := map[string]bool{}
+	for _, field := range fields {
+		declared[field.Name] = true
+
+		value, ok := attributes[field.Name]
+		if !ok {
+			if field.Required {
+				return fmt.Errorf("%w: %q is required", ErrInvalidAttributes, field.Name)
+			}
+			continue
+		}
+		if err := checkField(field, value); err != nil {
+			return err
+		}
+	}
+
+	for name := range attributes {
+		if !declared[name] {
+			return fmt.Errorf("%w: %q is not declared by the attribute schema", ErrInvalidAttributes, name)
+		}
+	}
+	return nil
+}
+
+func checkField(field models.AttributeField, value interface{}) error {
+	invalid := func(expected string) error {
+		return fmt.Errorf("%w: %q must be %s", ErrInvalidAttributes, field.Name, expected)
+	}
+
+	switch field.Type {
+	case models.AttributeString:
+		if _, ok := value.(string); !ok {
+			return invalid("a string")
+		}
+	case models.AttributeBoolean:
+		if _, ok := value.(bool); !ok {
+			return invalid("a boolean")
+		}
+	case models.AttributeEnum:
+		s, ok := value.(string)
+		if !ok {
+			return invalid("one of " + strings.Join(field.Values, ", "))
+		}
+		for _, allowed := range field.Values {
+			if s == allowed {
+				return nil
+			}
+		}
+		return invalid("one of " + strings.Join(field.Values, ", "))
+	case models.AttributeNumber, models.AttributeInteger:
+		n, ok := value.(float64)
+		if !ok {
+			return invalid("a number")
+		}
+		if field.Type == models.AttributeInteger && n != math.Trunc(n) {
+			return invalid("an integer")
+		}
+		if field.Min != nil && n < *field.Min {
+			return invalid(fmt.Sprintf("at least %g", *field.Min))
+		}
+		if field.Max != nil && n > *field.Max {
+			return invalid(fmt.Sprintf("at most %g", *field.Max))
+		}
+	}
+	return nil
+}
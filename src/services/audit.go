@@ -0,0 +1,111 @@
+package services
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+)
+
+// AuditContext identifies who performed a change and where the request came from.
+type AuditContext struct {
+	Actor     string
+	RequestID string
+	ClientIP  string
+}
+
+// SystemAudit is used for changes made by background jobs.
+var SystemAudit = AuditContext{Actor: "system"}
+
+// Bookkeeping columns that change on every write and would only add noise
+var unauditedFields = map[string]bool{
+	"created_at": true,
+	"updated_at": true,
+	"version":    true,
+}
+
+// RecordAudit writes an audit entry with the field-level diff between before and
+// after. Either side may be nil for creations and removals. Updates that change
+// nothing are not recorded.
+func RecordAudit(tx *gorm.DB, audit AuditContext, action string, before, after *models.Item) error {
+	changes := DiffItems(before, after)
+	if action == models.AuditUpdate && len(changes) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+
+	entry := models.AuditEntry{
+		Action:    action,
+		Actor:     audit.Actor,
+		RequestID: audit.RequestID,
+		ClientIP:  audit.ClientIP,
+		Changes:   models.JSON(payload),
+	}
+	if after != nil {
+		entry.ItemID = after.ID
+	} else if before != nil {
+		entry.ItemID = before.ID
+	}
+
+	return tx.Create(&entry).Error
+}
+
+// DiffItems compares every persisted field of two item states, keyed by JSON name.
+func DiffItems(before, after *models.Item) map[string]models.FieldChange {
+	changes := map[string]models.FieldChange{}
+
+	itemType := reflect.TypeOf(models.Item{})
+	for i := 0; i < itemType.NumField(); i++ {
+		field := itemType.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" || unauditedFields[name] {
+			continue
+		}
+		// Skip derived values and associations
+		if strings.HasPrefix(field.Tag.Get("gorm"), "-") || isAssociation(field.Type) {
+			continue
+		}
+
+		var oldValue, newValue interface{}
+		if before != nil {
+			oldValue = reflect.ValueOf(*before).Field(i).Interface()
+		}
+		if after != nil {
+			newValue = reflect.ValueOf(*after).Field(i).Interface()
+		}
+		if sameValue(oldValue, newValue) {
+			continue
+		}
+		changes[name] = models.FieldChange{Before: oldValue, After: newValue}
+	}
+
+	return changes
+}
+
+// isAssociation reports whether a field holds related records rather than a column.
+func isAssociation(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Slice, reflect.Ptr:
+		return t.Elem().Kind() == reflect.Struct
+	}
+	return false
+}
+
+// sameValue compares two field values; decimals are equal by value regardless of
+// scale, so 849.9 read back as 849.9000 is not reported as a change.
+func sameValue(a, b interface{}) bool {
+	if x, ok := a.(decimal.Decimal); ok {
+		if y, ok := b.(decimal.Decimal); ok {
+			return x.Equal(y)
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,214 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+)
+
+var (
+	ErrCategoryNotFound = errors.New("category not found")
+	ErrCategoryCycle    = errors.New("a category cannot be moved under itself or one of its subcategories")
+	ErrCategoryExists   = errors.New("a category with this name already exists under the same parent")
+	ErrCategoryInUse    = errors.New("category still has subcategories or items")
+)
+
+// CategoryRollup summarises the items in a category and all of its subcategories.
+// Stock value is totalled per currency, since prices in different currencies
+// cannot be added up.
+type CategoryRollup struct {
+	ID         string                     `json:"id"`
+	Name       string                     `json:"name"`
+	ParentID   *string                    `json:"parent_id"`
+	ItemCount  int64                      `json:"item_count"`
+	TotalStock int64                      `json:"total_stock"`
+	StockValue map[string]decimal.Decimal `json:"stock_value" swaggertype:"object,string" example:"USD:12499.75"`
+	Children   []*CategoryRollup          `json:"children"`
+}
+
+// EnsureCategory reports ErrCategoryNotFound unless the category exists.
+func EnsureCategory(tx *gorm.DB, id string) error {
+	var count int64
+	if err := tx.Model(&models.Category{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return fmt.Errorf("%w: %s", ErrCategoryNotFound, id)
+	}
+	return nil
+}
+
+// CategorySubtree returns the ID of a category followed by the IDs of all of its
+// descendants.
+func CategorySubtree(db *gorm.DB, id string) ([]string, error) {
+	var ids []string
+	err := db.Raw(`
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM categories WHERE id = ?
+			UNION ALL
+			SELECT categories.id FROM categories JOIN subtree ON categories.parent_id = subtree.id
+		)
+		SELECT id FROM subtree`, id).Scan(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrCategoryNotFound, id)
+	}
+	return ids, nil
+}
+
+// SaveCategory creates or updates a category after checking that its parent
+// exists, that the move does not create a cycle and that no sibling has its name.
+func SaveCategory(tx *gorm.DB, category *models.Category) error {
+	if category.ParentID != nil {
+		if err := EnsureCategory(tx, *category.ParentID); err != nil {
+			return err
+		}
+		if category.ID != "" {
+			subtree, err := CategorySubtree(tx, category.ID)
+			if err != nil {
+				return err
+			}
+			for _, id := range subtree {
+				if id == *category.ParentID {
+					return ErrCategoryCycle
+				}
+			}
+		}
+	}
+
+	// The unique index does not cover root categories, whose parent is NULL
+	siblings := tx.Model(&models.Category{}).Where("name = ?", category.Name)
+	if category.ParentID == nil {
+		siblings = siblings.Where("parent_id IS NULL")
+	} else {
+		siblings = siblings.Where("parent_id = ?", *category.ParentID)
+	}
+	if category.ID != "" {
+		siblings = siblings.Where("id <> ?", category.ID)
+	}
+	var count int64
+	if err := siblings.Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrCategoryExists
+	}
+
+	if category.ID == "" {
+		return tx.Create(category).Error
+	}
+	return tx.Save(category).Error
+}
+
+// DeleteCategory removes a category that has no subcategories and no items,
+// counting items in the trash since they may still be restored.
+func DeleteCategory(tx *gorm.DB, id string) error {
+	if err := EnsureCategory(tx, id); err != nil {
+		return err
+	}
+
+	var children, items int64
+	if err := tx.Model(&models.Category{}).Where("parent_id = ?", id).Count(&children).Error; err != nil {
+		return err
+	}
+	if err := tx.Unscoped().Model(&models.Item{}).Where("category_id = ?", id).Count(&items).Error; err != nil {
+		return err
+	}
+	if children > 0 || items > 0 {
+		return ErrCategoryInUse
+	}
+
+	return tx.Delete(&models.Category{}, "id = ?", id).Error
+}
+
+// RollupCategories builds the category tree with item counts, total stock and
+// stock value of every subtree. With rootID it returns only that category's
+// subtree; otherwise it returns every root category.
+func RollupCategories(db *gorm.DB, rootID string) ([]*CategoryRollup, error) {
+	var categories []models.Category
+	if err := db.Order("name asc").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	type total struct {
+		CategoryID string
+		Currency   string
+		Items      int64
+		Stock      int64
+		Value      decimal.Decimal
+	}
+	var totals []total
+	err := db.Model(&models.Item{}).
+		Select("category_id, currency, COUNT(*) AS items, COALESCE(SUM(stock), 0) AS stock, COALESCE(SUM(stock * price), 0) AS value").
+		Where("category_id IS NOT NULL").
+		Group("category_id, currency").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make(map[string]*CategoryRollup, len(categories))
+	for _, category := range categories {
+		nodes[category.ID] = &CategoryRollup{
+			ID:         category.ID,
+			Name:       category.Name,
+			ParentID:   category.ParentID,
+			StockValue: map[string]decimal.Decimal{},
+			Children:   []*CategoryRollup{},
+		}
+	}
+	for _, t := range totals {
+		if node, ok := nodes[t.CategoryID]; ok {
+			node.ItemCount += t.Items
+			node.TotalStock += t.Stock
+			node.StockValue[t.Currency] = node.StockValue[t.Currency].Add(t.Value)
+		}
+	}
+
+	var roots []*CategoryRollup
+	for _, category := range categories {
+		node := nodes[category.ID]
+		if parent, ok := nodes[stringValue(category.ParentID)]; ok {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+	for _, root := range roots {
+		accumulate(root)
+	}
+
+	if rootID == "" {
+		return roots, nil
+	}
+	node, ok := nodes[rootID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrCategoryNotFound, rootID)
+	}
+	return []*CategoryRollup{node}, nil
+}
+
+// accumulate adds the totals of every descendant into node.
+func accumulate(node *CategoryRollup) {
+	for _, child := range node.Children {
+		accumulate(child)
+		node.ItemCount += child.ItemCount
+		node.TotalStock += child.TotalStock
+		for currency, value := range child.StockValue {
+			node.StockValue[currency] = node.StockValue[currency].Add(value)
+		}
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
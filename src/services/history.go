@@ -0,0 +1,81 @@
+package services
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+)
+
+// SnapshotItem records the current name, stock, price and currency of an item in its
+// version history. It runs in the same transaction as the change it captures.
+func SnapshotItem(tx *gorm.DB, item *models.Item, deleted bool) error {
+	version := models.ItemVersion{
+		ItemID:        item.ID,
+		Version:       item.Version,
+		Name:          item.Name,
+		Stock:         item.Stock,
+		Price:         item.Price,
+		Currency:      item.Currency,
+		Deleted:       deleted,
+		ItemCreatedAt: item.CreatedAt,
+		RecordedAt:    time.Now(),
+	}
+	return tx.Create(&version).Error
+}
+
+// ItemsAsOf returns a subquery shaped like the items table holding the state of
+// every item that existed at asOf. Reserved, in-transit and quarantined quantities
+// are not versioned and read as zero.
+func ItemsAsOf(db *gorm.DB, asOf time.Time) *gorm.DB {
+	latest := db.Table("item_versions").
+		Select("DISTINCT ON (item_id) *").
+		Where("recorded_at <= ?", asOf).
+		Order("item_id, recorded_at desc, id desc")
+
+	// Business identifiers, categories, tags, attributes and reorder settings are not versioned and are taken from the current row
+	return db.Table("(?) AS snapshots", latest).
+		Joins("LEFT JOIN items AS current ON current.id = snapshots.item_id").
+		Select(`snapshots.item_id AS id, current.sku, current.barcode, current.category_id, current.tags, current.attributes, current.attribute_schema_id,
+			current.reorder_point, current.reorder_quantity, current.safety_stock, snapshots.name, snapshots.stock, 0 AS reserved,
+			0 AS in_transit, 0 AS quarantined, snapshots.price, snapshots.currency, snapshots.version, snapshots.item_created_at AS created_at,
+			snapshots.recorded_at AS updated_at, NULL::timestamptz AS deleted_at`).
+		Where("snapshots.deleted = ?", false)
+}
+
+// BackfillItemVersions gives every item without history a starting snapshot
+// dated at its creation (and a deleted snapshot for items already in the trash),
+// so items that predate versioning still appear in point-in-time queries.
+func BackfillItemVersions(db *gorm.DB) error {
+	var items []models.Item
+	err := db.Unscoped().
+		Where("NOT EXISTS (SELECT 1 FROM item_versions WHERE item_versions.item_id = items.id)").
+		Find(&items).Error
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		versions := []models.ItemVersion{{
+			ItemID:        item.ID,
+			Version:       item.Version,
+			Name:          item.Name,
+			Stock:         item.Stock,
+			Price:         item.Price,
+			Currency:      item.Currency,
+			ItemCreatedAt: item.CreatedAt,
+			RecordedAt:    item.CreatedAt,
+		}}
+		if item.DeletedAt.Valid {
+			deleted := versions[0]
+			deleted.Deleted = true
+			deleted.RecordedAt = item.DeletedAt.Time
+			versions = append(versions, deleted)
+		}
+		if err := db.Create(&versions).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+)
+
+var (
+	ErrInvalidIdentifier = errors.New("invalid identifier")
+	ErrIdentifierInUse   = errors.New("identifier already in use")
+)
+
+var skuPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,63}$`)
+
+// ValidateSKU checks that a SKU is 1-64 letters, digits, dots, dashes or underscores.
+func ValidateSKU(sku string) error {
+	if !skuPattern.MatchString(sku) {
+		return fmt.Errorf("%w: sku must be 1-64 letters, digits, '.', '-' or '_'", ErrInvalidIdentifier)
+	}
+	return nil
+}
+
+// ValidateBarcode checks that code is a GTIN-8, UPC-A (GTIN-12), EAN-13 or GTIN-14
+// with a correct check digit.
+func ValidateBarcode(code string) error {
+	switch len(code) {
+	case 8, 12, 13, 14:
+	default:
+		return fmt.Errorf("%w: barcode must have 8, 12, 13 or 14 digits", ErrInvalidIdentifier)
+	}
+
+	sum := 0
+	for i := len(code) - 1; i >= 0; i-- {
+		digit := code[i]
+		if digit < '0' || digit > '9' {
+			return fmt.Errorf("%w: barcode must contain only digits", ErrInvalidIdentifier)
+		}
+		if i == len(code)-1 {
+			continue
+		}
+		// Weights alternate 3, 1, 3, ... moving left from the check digit
+		weight := 1
+		if (len(code)-1-i)%2 == 1 {
+			weight = 3
+		}
+		sum += int(digit-'0') * weight
+	}
+	if check := (10 - sum%10) % 10; int(code[len(code)-1]-'0') != check {
+		return fmt.Errorf("%w: barcode check digit should be %d", ErrInvalidIdentifier, check)
+	}
+	return nil
+}
+
+// GTIN14 pads a barcode to 14 digits, so the UPC-A and EAN-13 forms of the same
+// product compare equal.
+func GTIN14(code string) string {
+	return strings.Repeat("0", 14-len(code)) + code
+}
+
+// EnsureIdentifiersFree reports ErrIdentifierInUse when another item, including one
+// in the trash, already has the item's SKU or an equivalent barcode. The unique
+// indexes remain the backstop for concurrent writers.
+func EnsureIdentifiersFree(tx *gorm.DB, item *models.Item) error {
+	others := tx.Unscoped().Model(&models.Item{})
+	if item.ID != "" {
+		others = others.Where("id <> ?", item.ID)
+	}
+
+	var count int64
+	if err := others.Session(&gorm.Session{}).Where("sku = ?", item.SKU).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: sku %q", ErrIdentifierInUse, item.SKU)
+	}
+
+	if item.Barcode != nil {
+		err := others.Session(&gorm.Session{}).
+			Where("lpad(barcode, 14, '0') = ?", GTIN14(*item.Barcode)).
+			Count(&count).Error
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return fmt.Errorf("%w: barcode %q", ErrIdentifierInUse, *item.Barcode)
+		}
+	}
+	return nil
+}
+
+// BackfillSKUs gives items created before SKUs existed one derived from their ID.
+// The whole ID is used so that no two derived SKUs can collide.
+func BackfillSKUs(db *gorm.DB) error {
+	return db.Exec(`UPDATE items SET sku = 'ITEM-' || upper(id::text) WHERE sku IS NULL OR sku = ''`).Error
+}
@@ -0,0 +1,48 @@
+package services
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateBarcode(t *testing.T) {
+	tests := []struct {
+		name  string
+		code  string
+		valid bool
+	}{
+		{"GTIN-8", "96385074", true},
+		{"GTIN-8 wrong check digit", "96385075", false},
+		{"UPC-A", "036000291452", true},
+		{"UPC-A wrong check digit", "036000291453", false},
+		{"EAN-13", "4006381333931", true},
+		{"EAN-13 wrong check digit", "4006381333932", false},
+		{"EAN-13 transposed digits", "4006383133931", false},
+		{"GTIN-14", "10012345678902", true},
+		{"GTIN-14 wrong check digit", "10012345678905", false},
+		{"check digit zero", "00012345600012", true},
+		{"too short", "1234567", false},
+		{"between lengths", "12345678901", false},
+		{"too long", "123456789012345", false},
+		{"letters", "40063813339X1", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBarcode(tt.code)
+			if tt.valid && err != nil {
+				t.Errorf("ValidateBarcode(%q) = %v, want nil", tt.code, err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidIdentifier) {
+				t.Errorf("ValidateBarcode(%q) = %v, want %v", tt.code, err, ErrInvalidIdentifier)
+			}
+		})
+	}
+}
+
+func TestGTIN14(t *testing.T) {
+	// The UPC-A and EAN-13 forms of one product compare equal once padded
+	if upc, ean := GTIN14("036000291452"), GTIN14("0036000291452"); upc != ean || upc != "00036000291452" {
+		t.Errorf("GTIN14 = %q and %q, want both %q", upc, ean, "00036000291452")
+	}
+}
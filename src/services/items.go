@@ -0,0 +1,404 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+)
+
+// ItemFilter selects and sorts the items listed through the REST and gRPC APIs.
+// The zero value lists every item, newest first.
+type ItemFilter struct {
+	Name               string
+	Currency           string
+	CategoryID         string
+	IncludeDescendants bool
+	// Tags must all be present on an item.
+	Tags []string
+	// Attributes maps an attribute name to the value it must equal. Names suffixed
+	// with _gt, _gte, _lt or _lte compare numerically instead.
+	Attributes map[string]string
+	AsOf       *time.Time
+	// Location is a location ID or code; only items stocked there are listed and
+	// MinStock applies to that location.
+	Location string
+	MinStock *int
+	SortBy   string
+	Order    string
+}
+
+// ItemPatch holds the item fields an update changes; nil fields are kept.
+type ItemPatch struct {
+	SKU *string
+	// An empty barcode, category or schema ID removes it.
+	Barcode         *string
+	Name            *string
+	CategoryID      *string
+	Tags            *[]string
+	Attributes      models.Attributes
+	SchemaID        *string
+	Stock           *int
+	Price           *decimal.Decimal
+	Currency        *string
+	ReorderPoint    *int
+	ReorderQuantity *int
+	SafetyStock     *int
+}
+
+// Range suffixes of numeric attribute filters and their SQL operators
+var attributeRanges = []struct {
+	suffix   string
+	operator string
+}{
+	{"_gte", ">="},
+	{"_lte", "<="},
+	{"_gt", ">"},
+	{"_lt", "<"},
+}
+
+// Sortable item columns; anything else sorts by created_at
+var itemSortFields = map[string]bool{
+	"name":       true,
+	"stock":      true,
+	"price":      true,
+	"created_at": true,
+}
+
+// ItemQuery builds the filtered and sorted item query for filter. Its errors are
+// client errors.
+func ItemQuery(db *gorm.DB, filter ItemFilter) (*gorm.DB, error) {
+	query := db.Model(&models.Item{})
+
+	// Point-in-time queries read reconstructed snapshots aliased as the items table,
+	// so every filter and sort below applies unchanged
+	if filter.AsOf != nil {
+		if filter.Location != "" {
+			return nil, errors.New("location cannot be combined with as_of")
+		}
+		query = db.Table("(?) AS items", ItemsAsOf(db, *filter.AsOf))
+	}
+
+	// Filters
+	if filter.Name != "" {
+		// Case-insensitive match (PostgreSQL)
+		query = query.Where("items.name ILIKE ?", "%"+filter.Name+"%")
+	}
+	// Prices in different currencies are not comparable, so sorting by price is
+	// most useful together with this filter
+	if filter.Currency != "" {
+		query = query.Where("items.currency = ?", filter.Currency)
+	}
+	if filter.CategoryID != "" {
+		categoryIDs := []string{filter.CategoryID}
+		if filter.IncludeDescendants {
+			var err error
+			if categoryIDs, err = CategorySubtree(db, filter.CategoryID); err != nil {
+				return nil, errors.New("unknown category")
+			}
+		} else if err := EnsureCategory(db, filter.CategoryID); err != nil {
+			return nil, errors.New("unknown category")
+		}
+		query = query.Where("items.category_id IN ?", categoryIDs)
+	}
+	// Every given tag must be present
+	for _, tag := range filter.Tags {
+		tagJSON, _ := json.Marshal([]string{strings.ToLower(tag)})
+		query = query.Where("items.tags @> ?::jsonb", string(tagJSON))
+	}
+	query, err := attributeFilters(query, filter.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	stockColumn := "items.stock"
+	if filter.Location != "" {
+		var location models.Location
+		if err := db.Where("code = ? OR id::text = ?", filter.Location, filter.Location).First(&location).Error; err != nil {
+			return nil, errors.New("unknown location")
+		}
+		query = query.
+			Joins("JOIN item_stocks ON item_stocks.item_id = items.id AND item_stocks.location_id = ?", location.ID).
+			Preload("Locations", "location_id = ?", location.ID).
+			Preload("Locations.Location")
+		stockColumn = "item_stocks.quantity"
+	}
+	if filter.MinStock != nil {
+		query = query.Where(stockColumn+" >= ?", *filter.MinStock)
+	}
+
+	// Sorting (whitelist fields) to prevent SQL injection
+	sortBy := filter.SortBy
+	if !itemSortFields[sortBy] {
+		sortBy = "created_at"
+	}
+	order := filter.Order
+	if order != "asc" && order != "desc" {
+		order = "desc"
+	}
+	return query.Order(fmt.Sprintf("items.%s %s", sortBy, order)), nil
+}
+
+// FindItem returns an item with its stock per location, or with asOf, the item as
+// it was at that time.
+func FindItem(db *gorm.DB, id string, asOf *time.Time) (*models.Item, error) {
+	query := db.Preload("Locations.Location").Preload("Category")
+	if asOf != nil {
+		query = db.Table("(?) AS items", ItemsAsOf(db, *asOf))
+	}
+
+	var item models.Item
+	err := query.First(&item, "items.id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrItemNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// ValidateItem checks the business identifiers and price of an item and normalizes
+// its tags before it is written.
+func ValidateItem(item *models.Item) error {
+	if err := ValidateSKU(item.SKU); err != nil {
+		return err
+	}
+	tags, err := NormalizeTags(item.Tags)
+	if err != nil {
+		return err
+	}
+	item.Tags = tags
+	if item.Attributes == nil {
+		item.Attributes = models.Attributes{}
+	}
+	if item.Barcode != nil {
+		if err := ValidateBarcode(*item.Barcode); err != nil {
+			return err
+		}
+	}
+	if err := ValidateReorderPolicy(item); err != nil {
+		return err
+	}
+	return ValidatePrice(item.Price, item.Currency)
+}
+
+// CreateItem inserts a validated item. Opening stock is recorded as a receipt so
+// the ledger explains it; the movement also snapshots the item into its version
+// history. The item is updated in place.
+func CreateItem(tx *gorm.DB, item *models.Item, openingStock int, audit AuditContext) error {
+	if err := EnsureIdentifiersFree(tx, item); err != nil {
+		return err
+	}
+	if item.CategoryID != nil {
+		if err := EnsureCategory(tx, *item.CategoryID); err != nil {
+			return err
+		}
+	}
+	if err := ValidateItemAttributes(tx, item); err != nil {
+		return err
+	}
+	if err := tx.Create(item).Error; err != nil {
+		return err
+	}
+	if err := RecordItemEvent(tx, audit, models.EventItemCreated, item, item); err != nil {
+		return err
+	}
+	if openingStock == 0 {
+		if err := SnapshotItem(tx, item, false); err != nil {
+			return err
+		}
+	} else {
+		updated, err := RecordMovement(tx, &models.StockMovement{
+			ItemID:    item.ID,
+			Delta:     openingStock,
+			Reason:    models.MovementReceipt,
+			Actor:     audit.Actor,
+			Reference: "initial stock",
+		})
+		if err != nil {
+			return err
+		}
+		*item = *updated
+	}
+	return RecordAudit(tx, audit, models.AuditCreate, nil, item)
+}
+
+// UpdateItem applies patch to the locked item after precondition, if any, accepts
+// its current state. Stock changes are recorded as adjustments against the ledger
+// rather than overwritten.
+func UpdateItem(tx *gorm.DB, id string, patch ItemPatch, audit AuditContext, precondition func(models.Item) error) (*models.Item, error) {
+	item, err := lockItem(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if precondition != nil {
+		if err := precondition(*item); err != nil {
+			return nil, err
+		}
+	}
+	before := *item
+
+	if patch.SKU != nil {
+		item.SKU = *patch.SKU
+	}
+	if patch.Barcode != nil {
+		item.Barcode = patch.Barcode
+		if *patch.Barcode == "" {
+			item.Barcode = nil
+		}
+	}
+	if patch.Name != nil {
+		item.Name = *patch.Name
+	}
+	if patch.CategoryID != nil {
+		item.CategoryID = patch.CategoryID
+		if *patch.CategoryID == "" {
+			item.CategoryID = nil
+		} else if err := EnsureCategory(tx, *patch.CategoryID); err != nil {
+			return nil, err
+		}
+	}
+	if patch.Price != nil {
+		item.Price = *patch.Price
+	}
+	if patch.Currency != nil {
+		item.Currency = *patch.Currency
+	}
+	if patch.Tags != nil {
+		item.Tags = *patch.Tags
+	}
+	// Attributes are replaced as a whole; an empty schema ID unassigns the schema
+	if patch.Attributes != nil {
+		item.Attributes = patch.Attributes
+	}
+	if patch.SchemaID != nil {
+		item.AttributeSchemaID = patch.SchemaID
+		if *patch.SchemaID == "" {
+			item.AttributeSchemaID = nil
+		}
+	}
+	if patch.ReorderPoint != nil {
+		item.ReorderPoint = *patch.ReorderPoint
+	}
+	if patch.ReorderQuantity != nil {
+		item.ReorderQuantity = *patch.ReorderQuantity
+	}
+	if patch.SafetyStock != nil {
+		item.SafetyStock = *patch.SafetyStock
+	}
+	if err := ValidateItem(item); err != nil {
+		return nil, err
+	}
+	if err := ValidateItemAttributes(tx, item); err != nil {
+		return nil, err
+	}
+	if err := EnsureIdentifiersFree(tx, item); err != nil {
+		return nil, err
+	}
+
+	item.Version++
+	if err := tx.Save(item).Error; err != nil {
+		return nil, err
+	}
+	if err := RecordItemEvent(tx, audit, models.EventItemUpdated, item, item); err != nil {
+		return nil, err
+	}
+
+	if patch.Stock != nil && *patch.Stock != item.Stock {
+		updated, err := RecordMovement(tx, &models.StockMovement{
+			ItemID:    item.ID,
+			Delta:     *patch.Stock - item.Stock,
+			Reason:    models.MovementAdjustment,
+			Actor:     audit.Actor,
+			Reference: "item update",
+		})
+		if err != nil {
+			return nil, err
+		}
+		item = updated
+	} else if err := SnapshotItem(tx, item, false); err != nil {
+		return nil, err
+	}
+	if err := RecordAudit(tx, audit, models.AuditUpdate, &before, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// DeleteItem moves the locked item to the trash after precondition, if any,
+// accepts its current state. Items with reserved or in-transit stock are refused.
+func DeleteItem(tx *gorm.DB, id string, audit AuditContext, precondition func(models.Item) error) error {
+	item, err := lockItem(tx, id)
+	if err != nil {
+		return err
+	}
+	if precondition != nil {
+		if err := precondition(*item); err != nil {
+			return err
+		}
+	}
+	if err := ensureNotHeld(item); err != nil {
+		return err
+	}
+
+	before := *item
+	if err := tx.Delete(item).Error; err != nil {
+		return err
+	}
+	if err := SnapshotItem(tx, &before, true); err != nil {
+		return err
+	}
+	if err := RecordItemEvent(tx, audit, models.EventItemDeleted, &before, before); err != nil {
+		return err
+	}
+	return RecordAudit(tx, audit, models.AuditDelete, &before, nil)
+}
+
+// attributeFilters applies equality filters and _gt, _gte, _lt and _lte numeric
+// range filters on item attributes.
+func attributeFilters(query *gorm.DB, filters map[string]string) (*gorm.DB, error) {
+	keys := make([]string, 0, len(filters))
+	for key := range filters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		name, value := key, filters[key]
+
+		operator := ""
+		for _, r := range attributeRanges {
+			if strings.HasSuffix(name, r.suffix) {
+				name, operator = strings.TrimSuffix(name, r.suffix), r.operator
+				break
+			}
+		}
+		if !AttributeNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("invalid attribute filter %q", "attr."+key)
+		}
+
+		if operator == "" {
+			query = query.Where("items.attributes ->> ?::text = ?", name, value)
+			continue
+		}
+		bound, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("attr.%s must be a number", key)
+		}
+		// Non-numeric values never match rather than failing the cast
+		query = query.Where(
+			"CASE WHEN jsonb_typeof(items.attributes -> ?::text) = 'number' THEN (items.attributes ->> ?::text)::numeric END "+operator+" ?",
+			name, name, bound,
+		)
+	}
+	return query, nil
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"inventory-service/src/models"
+)
+
+var (
+	ErrLocationNotFound = errors.New("location not found")
+	ErrLocationInUse    = errors.New("location still holds stock")
+)
+
+// DefaultLocationCode is the code of the location created for stock recorded
+// without an explicit location.
+const DefaultLocationCode = "DEFAULT"
+
+// ReconcileLocations makes sure a default location exists and that every item's
+// stock equals the sum of its per-location quantities. Missing stock (such as stock
+// recorded before locations existed) is placed at the default location and surplus
+// is drained like an outbound movement. Items that cannot be reconciled are logged
+// and left alone rather than keeping the service from starting.
+func ReconcileLocations(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if _, err := defaultLocationID(tx); err != nil {
+			return err
+		}
+
+		type drift struct {
+			ID         string
+			Difference int
+		}
+
+		var drifts []drift
+		err := tx.Table("items").
+			Select("items.id, items.stock - COALESCE(SUM(item_stocks.quantity), 0) AS difference").
+			Joins("LEFT JOIN item_stocks ON item_stocks.item_id = items.id").
+			Group("items.id, items.stock").
+			Having("items.stock <> COALESCE(SUM(item_stocks.quantity), 0)").
+			Scan(&drifts).Error
+		if err != nil {
+			return err
+		}
+
+		for _, d := range drifts {
+			// A savepoint per item undoes its partial changes when it fails
+			err := tx.Transaction(func(tx *gorm.DB) error {
+				return applyLocationDelta(tx, d.ID, nil, d.Difference)
+			})
+			if err != nil {
+				log.Printf("reconcile locations: item %s is %d off its locations: %v", d.ID, d.Difference, err)
+			}
+		}
+		return nil
+	})
+}
+
+// applyLocationDelta mirrors an item-level stock change onto its locations.
+// Inbound stock without a location lands at the default location; outbound stock
+// without a location is drawn from the default location first and then from the
+// locations holding the most units.
+func applyLocationDelta(tx *gorm.DB, itemID string, locationID *string, delta int) error {
+	if locationID != nil {
+		var location models.Location
+		if err := tx.First(&location, "id = ?", *locationID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrLocationNotFound
+			}
+			return err
+		}
+		return adjustLocationStock(tx, itemID, location.ID, delta)
+	}
+
+	if delta > 0 {
+		defaultID, err := defaultLocationID(tx)
+		if err != nil {
+			return err
+		}
+		return adjustLocationStock(tx, itemID, defaultID, delta)
+	}
+
+	var stocks []models.ItemStock
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: "item_stocks"}}).
+		Joins("JOIN locations ON locations.id = item_stocks.location_id").
+		Where("item_stocks.item_id = ? AND item_stocks.quantity > 0", itemID).
+		Order("locations.is_default desc, item_stocks.quantity desc").
+		Find(&stocks).Error
+	if err != nil {
+		return err
+	}
+
+	remaining := -delta
+	for _, stock := range stocks {
+		if remaining == 0 {
+			break
+		}
+		take := min(stock.Quantity, remaining)
+		if err := adjustLocationStock(tx, itemID, stock.LocationID, -take); err != nil {
+			return err
+		}
+		remaining -= take
+	}
+	if remaining > 0 {
+		return ErrInsufficientStock
+	}
+	return nil
+}
+
+// adjustLocationStock adds delta to the quantity of an item held at a location,
+// refusing to take the location below zero.
+func adjustLocationStock(tx *gorm.DB, itemID, locationID string, delta int) error {
+	if delta >= 0 {
+		stock := models.ItemStock{ItemID: itemID, LocationID: locationID, Quantity: delta}
+		return tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "item_id"}, {Name: "location_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"quantity":   gorm.Expr("item_stocks.quantity + ?", delta),
+				"updated_at": time.Now(),
+			}),
+		}).Create(&stock).Error
+	}
+
+	result := tx.Model(&models.ItemStock{}).
+		Where("item_id = ? AND location_id = ? AND quantity >= ?", itemID, locationID, -delta).
+		Update("quantity", gorm.Expr("quantity + ?", delta))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrInsufficientStock
+	}
+	return nil
+}
+
+// defaultLocationID returns the default location, creating it on first use.
+func defaultLocationID(tx *gorm.DB) (string, error) {
+	var location models.Location
+	err := tx.Where("is_default = ?", true).First(&location).Error
+	if err == nil {
+		return location.ID, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", err
+	}
+
+	location = models.Location{Code: DefaultLocationCode, Name: "Default location", IsDefault: true}
+	if err := tx.Create(&location).Error; err != nil {
+		return "", err
+	}
+	return location.ID, nil
+}
@@ -0,0 +1,25 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"inventory-service/src/models"
+)
+
+var ErrInvalidPrice = errors.New("invalid price")
+
+// ValidatePrice checks that price is not negative and has no more decimal places
+// than the minor unit of its currency. Currency codes are validated on binding.
+func ValidatePrice(price decimal.Decimal, currency string) error {
+	if price.IsNegative() {
+		return fmt.Errorf("%w: price must not be negative", ErrInvalidPrice)
+	}
+	units := models.CurrencyMinorUnits(currency)
+	if !price.Equal(price.Truncate(units)) {
+		return fmt.Errorf("%w: %s allows at most %d decimal places", ErrInvalidPrice, currency, units)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestValidatePrice(t *testing.T) {
+	tests := []struct {
+		price    string
+		currency string
+		valid    bool
+	}{
+		{"999.99", "USD", true},
+		{"0", "USD", true},
+		{"10.50", "USD", true},
+		{"-0.01", "USD", false},
+		{"-5", "JPY", false},
+		{"999.999", "USD", false},
+		{"1500", "JPY", true},
+		{"1500.5", "JPY", false},
+		{"1.250", "KWD", true},
+		{"1.2505", "KWD", false},
+		{"0.0001", "CLF", true},
+		{"1.5", "XYZ", true},
+		{"1.005", "XYZ", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.price+" "+tt.currency, func(t *testing.T) {
+			err := ValidatePrice(decimal.RequireFromString(tt.price), tt.currency)
+			if tt.valid && err != nil {
+				t.Errorf("ValidatePrice = %v, want nil", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidPrice) {
+				t.Errorf("ValidatePrice = %v, want %v", err, ErrInvalidPrice)
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	"inventory-service/src/events"
+	"inventory-service/src/models"
+)
+
+// EventStream is the Redis Stream domain events are published to.
+var EventStream = "inventory:events"
+
+// EventChannel is the Redis pub/sub channel every published event is also sent to
+// for live subscribers.
+var EventChannel = "inventory:events:live"
+
+// EventStreamMaxLen caps the event stream; Redis trims the oldest entries past it.
+const EventStreamMaxLen = 1000000
+
+// Events read per query when a stream resumes
+const replayBatch = 500
+
+// StockChange is the data of a stock.changed event.
+type StockChange struct {
+	SKU       string               `json:"sku" example:"LAPTOP-001"`
+	Name      string               `json:"name" example:"Laptop"`
+	Movement  models.StockMovement `json:"movement"`
+	Stock     int                  `json:"stock" example:"42"`
+	Reserved  int                  `json:"reserved" example:"2"`
+	Available int                  `json:"available" example:"40"`
+}
+
+// ReservedChange is the data of a stock.reserved event. Delta is the change in
+// reserved units.
+type ReservedChange struct {
+	SKU       string `json:"sku" example:"LAPTOP-001"`
+	Name      string `json:"name" example:"Laptop"`
+	Delta     int    `json:"delta" example:"2"`
+	Stock     int    `json:"stock" example:"42"`
+	Reserved  int    `json:"reserved" example:"4"`
+	Available int    `json:"available" example:"38"`
+}
+
+// RecordItemEvent writes a domain event about item to the outbox and queues its
+// delivery to matching webhook subscriptions. It must run in the transaction that
+// made the change so the event commits, or not, with it.
+func RecordItemEvent(tx *gorm.DB, audit AuditContext, eventType string, item *models.Item, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	event := models.OutboxEvent{
+		Type:             eventType,
+		AggregateType:    "item",
+		AggregateID:      item.ID,
+		AggregateVersion: item.Version,
+		Actor:            audit.Actor,
+		RequestID:        audit.RequestID,
+		Data:             models.JSON(payload),
+	}
+	if err := tx.Create(&event).Error; err != nil {
+		return err
+	}
+	return enqueueWebhooks(tx, &event)
+}
+
+// RelayOutbox publishes committed, unpublished outbox events to the event stream
+// and reports how many were published. Events are given positions in a
+// transaction of their own and published only once it has committed, so an event
+// a subscriber receives live can always be read back from the outbox. Only one
+// replica relays at a time, holding the lock until publishing is done, so
+// positions increase in stream order even where events committed out of sequence
+// order. An event is marked published only after Redis accepted it, so one
+// published just before a crash is published again under the same position:
+// delivery is at least once and consumers deduplicate on position.
+func RelayOutbox(ctx context.Context, db *gorm.DB, client *redis.Client) (int, error) {
+	published := 0
+	err := db.Connection(func(conn *gorm.DB) error {
+		var locked bool
+		if err := conn.Raw("SELECT pg_try_advisory_lock(hashtext('outbox_relay'))").Scan(&locked).Error; err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+		defer conn.Exec("SELECT pg_advisory_unlock(hashtext('outbox_relay'))")
+
+		events, err := positionEvents(conn)
+		if err != nil || len(events) == 0 {
+			return err
+		}
+
+		// Events Redis accepted before a failure are still marked published
+		var relayErr error
+		var positions []int64
+		for _, event := range events {
+			if relayErr = publishEvent(ctx, client, event); relayErr != nil {
+				break
+			}
+			positions = append(positions, *event.Position)
+		}
+		if len(positions) > 0 {
+			err := conn.Model(&models.OutboxEvent{}).Where("position IN ?", positions).
+				Update("published_at", time.Now()).Error
+			if err != nil {
+				return err
+			}
+			published = len(positions)
+		}
+		return relayErr
+	})
+	return published, err
+}
+
+// positionEvents commits positions for the next unpublished events and returns
+// them in position order. Events an interrupted run already positioned keep their
+// position and come first.
+func positionEvents(db *gorm.DB) ([]models.OutboxEvent, error) {
+	var events []models.OutboxEvent
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("published_at IS NULL").Order("position IS NULL, position, sequence").Limit(100).Find(&events).Error
+		if err != nil {
+			return err
+		}
+		unpositioned := 0
+		for _, event := range events {
+			if event.Position == nil {
+				unpositioned++
+			}
+		}
+		if unpositioned == 0 {
+			return nil
+		}
+
+		// Positions come from a sequence rather than the table, so a position
+		// taken before a rollback is never handed to a different event
+		var positions []int64
+		err = tx.Raw("SELECT nextval('outbox_event_positions') FROM generate_series(1, ?)", unpositioned).
+			Scan(&positions).Error
+		if err != nil {
+			return err
+		}
+		sort.Slice(positions, func(i, j int) bool { return positions[i] < positions[j] })
+
+		next := 0
+		for i := range events {
+			if events[i].Position != nil {
+				continue
+			}
+			events[i].Position = &positions[next]
+			next++
+			if err := tx.Model(&events[i]).Update("position", *events[i].Position).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return events, err
+}
+
+// BackfillEventPositions creates the sequence the relay takes positions from and
+// gives events published before positions existed their sequence as position,
+// which is the order they were published in.
+func BackfillEventPositions(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("CREATE SEQUENCE IF NOT EXISTS outbox_event_positions").Error; err != nil {
+			return err
+		}
+		err := tx.Exec("UPDATE outbox_events SET position = sequence WHERE position IS NULL AND published_at IS NOT NULL").Error
+		if err != nil {
+			return err
+		}
+		return tx.Exec(`SELECT setval('outbox_event_positions', MAX(position)) FROM outbox_events
+			HAVING MAX(position) >= (SELECT last_value FROM outbox_event_positions)`).Error
+	})
+}
+
+// EventsAfter returns up to limit events with a position greater than position, in
+// stream order. Positions commit before their events are published, so these
+// include every event a subscriber can have received live, and may include some
+// the relay has yet to publish.
+func EventsAfter(db *gorm.DB, position int64, limit int) ([]models.OutboxEvent, error) {
+	var events []models.OutboxEvent
+	err := db.Where("position > ?", position).Order("position").Limit(limit).Find(&events).Error
+	return events, err
+}
+
+// LatestPosition returns the highest committed event position, or 0 when there is
+// none. State read after it reflects every event at or below it.
+func LatestPosition(db *gorm.DB) (int64, error) {
+	var position int64
+	err := db.Model(&models.OutboxEvent{}).Select("COALESCE(MAX(position), 0)").Scan(&position).Error
+	return position, err
+}
+
+// Watermark is the highest position a stream has sent. Events are published in
+// position order, so a live event at or below it was already sent, by replay or
+// as a redelivery.
+type Watermark int64
+
+// Seen reports whether event is at or below the watermark, and otherwise raises
+// the watermark to it.
+func (watermark *Watermark) Seen(event models.OutboxEvent) bool {
+	if event.Position == nil || *event.Position <= int64(*watermark) {
+		return true
+	}
+	*watermark = Watermark(*event.Position)
+	return false
+}
+
+// ReplayAfter passes the events after position that match filter to send, in
+// stream order, so a live stream can resume where a client left off, and returns
+// the position it replayed through. Streams subscribe to live events before
+// replaying, so an event published meanwhile may arrive both ways; skipping live
+// events the returned Watermark has seen sends it once.
+func ReplayAfter(db *gorm.DB, position int64, filter events.Filter, send func(models.OutboxEvent) error) (Watermark, error) {
+	for {
+		backlog, err := EventsAfter(db, position, replayBatch)
+		if err != nil {
+			return Watermark(position), err
+		}
+		for _, event := range backlog {
+			position = *event.Position
+			if !filter.Match(event) {
+				continue
+			}
+			if err := send(event); err != nil {
+				return Watermark(position), err
+			}
+		}
+		if len(backlog) < replayBatch {
+			return Watermark(position), nil
+		}
+	}
+}
+
+// PrunePublishedEvents deletes events published before cutoff and reports how many
+// were removed.
+func PrunePublishedEvents(db *gorm.DB, cutoff time.Time) (int64, error) {
+	result := db.Where("published_at < ?", cutoff).Delete(&models.OutboxEvent{})
+	return result.RowsAffected, result.Error
+}
+
+// publishEvent appends one event to the stream and announces it on the live
+// channel in a single MULTI. The routing fields are repeated outside the envelope
+// so consumers can filter without decoding it.
+func publishEvent(ctx context.Context, client *redis.Client, event models.OutboxEvent) error {
+	envelope, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	pipe := client.TxPipeline()
+	pipe.XAdd(ctx, &redis.XAddArgs{
+		Stream: EventStream,
+		MaxLen: EventStreamMaxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"id":           event.ID,
+			"sequence":     event.Sequence,
+			"position":     *event.Position,
+			"type":         event.Type,
+			"aggregate_id": event.AggregateID,
+			"event":        envelope,
+		},
+	})
+	pipe.Publish(ctx, EventChannel, envelope)
+	_, err = pipe.Exec(ctx)
+	return err
+}
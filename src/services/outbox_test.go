@@ -0,0 +1,40 @@
+package services
+
+import (
+	"testing"
+
+	"inventory-service/src/models"
+)
+
+func TestWatermarkSeen(t *testing.T) {
+	at := func(position int64) models.OutboxEvent {
+		return models.OutboxEvent{Position: &position}
+	}
+
+	// Replayed through 10: replayed events arriving live and redeliveries are
+	// skipped, newer events are sent once
+	watermark := Watermark(10)
+	tests := []struct {
+		name  string
+		event models.OutboxEvent
+		seen  bool
+	}{
+		{"replayed", at(9), true},
+		{"last replayed", at(10), true},
+		{"next", at(11), false},
+		{"redelivered", at(11), true},
+		{"after a gap", at(14), false},
+		{"redelivered before", at(12), true},
+		{"unpositioned", models.OutboxEvent{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := watermark.Seen(tt.event); got != tt.seen {
+				t.Errorf("Seen = %v, want %v (watermark %d)", got, tt.seen, watermark)
+			}
+		})
+	}
+	if watermark != 14 {
+		t.Errorf("watermark = %d, want 14", watermark)
+	}
+}
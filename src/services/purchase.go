@@ -0,0 +1,292 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"inventory-service/src/models"
+)
+
+var (
+	ErrPurchaseOrderNotFound = errors.New("purchase order not found")
+	ErrInvalidPurchaseOrder  = errors.New("invalid purchase order")
+	ErrPurchaseOrderStatus   = errors.New("purchase order does not allow this in its current status")
+	ErrOverReceipt           = errors.New("receipt exceeds the quantity outstanding")
+)
+
+// PurchaseLineInput is a requested purchase order line. Without a unit cost the
+// supplier's cost for the item is used.
+type PurchaseLineInput struct {
+	ItemID   string
+	Quantity int
+	UnitCost *decimal.Decimal
+}
+
+// ReceiptLine is a quantity received against one purchase order line.
+type ReceiptLine struct {
+	LineID   string
+	Quantity int
+}
+
+// SavePurchaseOrder creates a draft purchase order, or replaces the header and
+// lines of an existing one while it is still a draft. Lines are priced in the
+// supplier's currency.
+func SavePurchaseOrder(tx *gorm.DB, order *models.PurchaseOrder, lines []PurchaseLineInput) error {
+	if order.ID != "" {
+		current, err := lockPurchaseOrder(tx, order.ID)
+		if err != nil {
+			return err
+		}
+		if current.Status != models.PurchaseDraft {
+			return fmt.Errorf("%w: only draft orders can be edited, order is %s", ErrPurchaseOrderStatus, current.Status)
+		}
+		order.Status = current.Status
+		order.CreatedBy = current.CreatedBy
+		order.CreatedAt = current.CreatedAt
+	} else {
+		order.Status = models.PurchaseDraft
+	}
+
+	var supplier models.Supplier
+	if err := tx.First(&supplier, "id = ?", order.SupplierID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrSupplierNotFound
+		}
+		return err
+	}
+	order.Currency = supplier.Currency
+
+	if order.LocationID != nil {
+		var location models.Location
+		if err := tx.First(&location, "id = ?", *order.LocationID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrLocationNotFound
+			}
+			return err
+		}
+	}
+
+	priced, err := pricePurchaseLines(tx, supplier, lines)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Omit("Lines").Save(order).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("purchase_order_id = ?", order.ID).Delete(&models.PurchaseOrderLine{}).Error; err != nil {
+		return err
+	}
+	for i := range priced {
+		priced[i].PurchaseOrderID = order.ID
+	}
+	if err := tx.Create(&priced).Error; err != nil {
+		return err
+	}
+
+	order.Supplier = &supplier
+	order.Lines = priced
+	return nil
+}
+
+// SendPurchaseOrder marks a draft order as sent to the supplier.
+func SendPurchaseOrder(tx *gorm.DB, id string) (*models.PurchaseOrder, error) {
+	order, err := lockPurchaseOrder(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != models.PurchaseDraft {
+		return nil, fmt.Errorf("%w: only draft orders can be sent, order is %s", ErrPurchaseOrderStatus, order.Status)
+	}
+
+	now := time.Now()
+	order.Status = models.PurchaseSent
+	order.SentAt = &now
+	if err := tx.Omit("Lines").Save(order).Error; err != nil {
+		return nil, err
+	}
+	return order, loadPurchaseLines(tx, order)
+}
+
+// CancelPurchaseOrder closes an order that is not yet fully received. Units already
+// received stay in stock.
+func CancelPurchaseOrder(tx *gorm.DB, id string) (*models.PurchaseOrder, error) {
+	order, err := lockPurchaseOrder(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status == models.PurchaseReceived || order.Status == models.PurchaseCancelled {
+		return nil, fmt.Errorf("%w: order is %s", ErrPurchaseOrderStatus, order.Status)
+	}
+
+	now := time.Now()
+	order.Status = models.PurchaseCancelled
+	order.CancelledAt = &now
+	if err := tx.Omit("Lines").Save(order).Error; err != nil {
+		return nil, err
+	}
+	return order, loadPurchaseLines(tx, order)
+}
+
+// DeletePurchaseOrder removes a draft order and its lines.
+func DeletePurchaseOrder(tx *gorm.DB, id string) error {
+	order, err := lockPurchaseOrder(tx, id)
+	if err != nil {
+		return err
+	}
+	if order.Status != models.PurchaseDraft {
+		return fmt.Errorf("%w: only draft orders can be deleted, order is %s", ErrPurchaseOrderStatus, order.Status)
+	}
+
+	if err := tx.Where("purchase_order_id = ?", order.ID).Delete(&models.PurchaseOrderLine{}).Error; err != nil {
+		return err
+	}
+	return tx.Delete(order).Error
+}
+
+// ReceivePurchaseOrder books received units of a sent order into stock as receipt
+// movements at locationID (the order's location when nil) and advances the order to
+// partially_received or received. Receiving more than a line's outstanding quantity
+// is rejected unless allowOverReceipt is set.
+func ReceivePurchaseOrder(tx *gorm.DB, id string, receipts []ReceiptLine, locationID *string, allowOverReceipt bool, actor string) (*models.PurchaseOrder, error) {
+	order, err := lockPurchaseOrder(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != models.PurchaseSent && order.Status != models.PurchasePartiallyReceived {
+		return nil, fmt.Errorf("%w: only sent orders can be received, order is %s", ErrPurchaseOrderStatus, order.Status)
+	}
+	if len(receipts) == 0 {
+		return nil, fmt.Errorf("%w: nothing to receive", ErrInvalidPurchaseOrder)
+	}
+	if locationID == nil {
+		locationID = order.LocationID
+	}
+
+	if err := loadPurchaseLines(tx, order); err != nil {
+		return nil, err
+	}
+	lines := map[string]*models.PurchaseOrderLine{}
+	for i := range order.Lines {
+		lines[order.Lines[i].ID] = &order.Lines[i]
+	}
+
+	for _, receipt := range receipts {
+		line, ok := lines[receipt.LineID]
+		if !ok {
+			return nil, fmt.Errorf("%w: line %s is not on this order", ErrInvalidPurchaseOrder, receipt.LineID)
+		}
+		if receipt.Quantity <= 0 {
+			return nil, fmt.Errorf("%w: quantity must be positive", ErrInvalidPurchaseOrder)
+		}
+		if receipt.Quantity > line.Outstanding() && !allowOverReceipt {
+			return nil, fmt.Errorf("%w: line %s has %d outstanding", ErrOverReceipt, line.ID, line.Outstanding())
+		}
+
+		_, err := RecordMovement(tx, &models.StockMovement{
+			ItemID:     line.ItemID,
+			LocationID: locationID,
+			Delta:      receipt.Quantity,
+			Reason:     models.MovementReceipt,
+			Actor:      actor,
+			Reference:  "purchase_order:" + order.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		line.QuantityReceived += receipt.Quantity
+		if err := tx.Model(line).Update("quantity_received", line.QuantityReceived).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	order.Status = models.PurchaseReceived
+	for _, line := range order.Lines {
+		if line.Outstanding() > 0 {
+			order.Status = models.PurchasePartiallyReceived
+			break
+		}
+	}
+	if order.Status == models.PurchaseReceived {
+		now := time.Now()
+		order.ReceivedAt = &now
+	}
+	if err := tx.Omit("Lines").Save(order).Error; err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+func pricePurchaseLines(tx *gorm.DB, supplier models.Supplier, inputs []PurchaseLineInput) ([]models.PurchaseOrderLine, error) {
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("%w: at least one line is required", ErrInvalidPurchaseOrder)
+	}
+
+	seen := map[string]bool{}
+	lines := make([]models.PurchaseOrderLine, 0, len(inputs))
+	for i, input := range inputs {
+		if input.Quantity <= 0 {
+			return nil, fmt.Errorf("%w: quantity must be positive", ErrInvalidPurchaseOrder)
+		}
+		if seen[input.ItemID] {
+			return nil, fmt.Errorf("%w: item %s appears on more than one line", ErrInvalidPurchaseOrder, input.ItemID)
+		}
+		seen[input.ItemID] = true
+
+		var item models.Item
+		if err := tx.First(&item, "id = ?", input.ItemID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, fmt.Errorf("%w: %s", ErrItemNotFound, input.ItemID)
+			}
+			return nil, err
+		}
+
+		var cost decimal.Decimal
+		if input.UnitCost != nil {
+			cost = *input.UnitCost
+		} else {
+			var link models.ItemSupplier
+			err := tx.First(&link, "item_id = ? AND supplier_id = ?", item.ID, supplier.ID).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, fmt.Errorf("%w: unit_cost is required for %s, which %s does not supply", ErrInvalidPurchaseOrder, item.SKU, supplier.Name)
+			}
+			if err != nil {
+				return nil, err
+			}
+			cost = link.UnitCost
+		}
+		if err := ValidatePrice(cost, supplier.Currency); err != nil {
+			return nil, fmt.Errorf("unit cost of %s: %w", item.SKU, err)
+		}
+
+		lines = append(lines, models.PurchaseOrderLine{
+			ItemID:   item.ID,
+			Position: i + 1,
+			Quantity: input.Quantity,
+			UnitCost: cost,
+		})
+	}
+	return lines, nil
+}
+
+func lockPurchaseOrder(tx *gorm.DB, id string) (*models.PurchaseOrder, error) {
+	var order models.PurchaseOrder
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrPurchaseOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+func loadPurchaseLines(tx *gorm.DB, order *models.PurchaseOrder) error {
+	return tx.Where("purchase_order_id = ?", order.ID).Order("position asc").Find(&order.Lines).Error
+}
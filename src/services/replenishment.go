@@ -0,0 +1,186 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"inventory-service/src/models"
+)
+
+var ErrInvalidReorderPolicy = errors.New("invalid reorder policy")
+
+// ReplenishmentSuggestion is an item at or below its reorder point and how much of
+// it to order. Position is the inventory position the reorder point is compared
+// against: available and in-transit units plus units on open purchase orders,
+// less units backordered on sales orders.
+type ReplenishmentSuggestion struct {
+	Item              models.Item          `json:"item"`
+	OnOrder           int                  `json:"on_order" example:"0"`
+	Backordered       int                  `json:"backordered" example:"3"`
+	Position          int                  `json:"position" example:"4"`
+	SuggestedQuantity int                  `json:"suggested_quantity" example:"20"`
+	Supplier          *models.ItemSupplier `json:"supplier,omitempty"`
+}
+
+// replenishmentRow is an item with the open purchase and sales quantities the
+// report needs.
+type replenishmentRow struct {
+	models.Item
+	OnOrder     int
+	Backordered int
+}
+
+// openPurchaseStatuses are the purchase order states whose outstanding units count
+// as on order. Drafts count so scheduled runs do not draft the same shortfall twice.
+var openPurchaseStatuses = []string{models.PurchaseDraft, models.PurchaseSent, models.PurchasePartiallyReceived}
+
+// ValidateReorderPolicy checks an item's reorder settings: an item with a reorder
+// point must say how much to reorder.
+func ValidateReorderPolicy(item *models.Item) error {
+	if item.ReorderPoint < 0 || item.ReorderQuantity < 0 || item.SafetyStock < 0 {
+		return fmt.Errorf("%w: reorder settings must not be negative", ErrInvalidReorderPolicy)
+	}
+	if item.ReorderPoint > 0 && item.ReorderQuantity == 0 {
+		return fmt.Errorf("%w: reorder_quantity is required with a reorder_point", ErrInvalidReorderPolicy)
+	}
+	return nil
+}
+
+// ReplenishmentReport lists every item with a reorder point whose inventory
+// position is at or below it, lowest position relative to the reorder point first.
+// The suggested quantity is the reorder quantity, raised to bring the position back
+// up to reorder point plus safety stock and to the supplier's minimum order
+// quantity. The supplier is the item's preferred supplier, else its cheapest.
+func ReplenishmentReport(db *gorm.DB) ([]ReplenishmentSuggestion, error) {
+	onOrder := db.Table("purchase_order_lines").
+		Select("purchase_order_lines.item_id, SUM(GREATEST(purchase_order_lines.quantity - purchase_order_lines.quantity_received, 0)) AS quantity").
+		Joins("JOIN purchase_orders ON purchase_orders.id = purchase_order_lines.purchase_order_id").
+		Where("purchase_orders.status IN ?", openPurchaseStatuses).
+		Group("purchase_order_lines.item_id")
+	backordered := db.Table("sales_order_lines").
+		Select("sales_order_lines.item_id, SUM(sales_order_lines.quantity - sales_order_lines.quantity_allocated - sales_order_lines.quantity_shipped) AS quantity").
+		Joins("JOIN sales_orders ON sales_orders.id = sales_order_lines.sales_order_id").
+		Where("sales_orders.status NOT IN ?", []string{models.SalesShipped, models.SalesCancelled}).
+		Group("sales_order_lines.item_id")
+
+	position := "items.stock - items.reserved + items.in_transit + COALESCE(on_order.quantity, 0) - COALESCE(backordered.quantity, 0)"
+
+	var rows []replenishmentRow
+	err := db.Table("items").
+		Select("items.*, COALESCE(on_order.quantity, 0) AS on_order, COALESCE(backordered.quantity, 0) AS backordered").
+		Joins("LEFT JOIN (?) AS on_order ON on_order.item_id = items.id", onOrder).
+		Joins("LEFT JOIN (?) AS backordered ON backordered.item_id = items.id", backordered).
+		Where("items.reorder_point > 0").
+		Where(position + " <= items.reorder_point").
+		Order(position + " - items.reorder_point, items.name").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	suppliers, err := replenishmentSuppliers(db, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]ReplenishmentSuggestion, 0, len(rows))
+	for _, row := range rows {
+		item := row.Item
+		suggestion := ReplenishmentSuggestion{
+			Item:        item,
+			OnOrder:     row.OnOrder,
+			Backordered: row.Backordered,
+			Position:    item.Stock - item.Reserved + item.InTransit + row.OnOrder - row.Backordered,
+			Supplier:    suppliers[item.ID],
+		}
+		suggestion.SuggestedQuantity = max(item.ReorderQuantity, item.ReorderPoint+item.SafetyStock-suggestion.Position)
+		if suggestion.Supplier != nil {
+			suggestion.SuggestedQuantity = max(suggestion.SuggestedQuantity, suggestion.Supplier.MinOrderQuantity)
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+	return suggestions, nil
+}
+
+// DraftReplenishmentOrders drafts one purchase order per supplier for the items in
+// the replenishment report and reports how many orders it drafted. Items without a
+// supplier are left to be ordered by hand. Concurrent runs on other replicas are
+// skipped.
+func DraftReplenishmentOrders(db *gorm.DB) (int, error) {
+	drafted := 0
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var locked bool
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(hashtext('replenishment'))").Scan(&locked).Error; err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+
+		suggestions, err := ReplenishmentReport(tx)
+		if err != nil {
+			return err
+		}
+
+		var supplierIDs []string
+		lines := map[string][]PurchaseLineInput{}
+		for _, suggestion := range suggestions {
+			if suggestion.Supplier == nil {
+				continue
+			}
+			supplierID := suggestion.Supplier.SupplierID
+			if _, ok := lines[supplierID]; !ok {
+				supplierIDs = append(supplierIDs, supplierID)
+			}
+			lines[supplierID] = append(lines[supplierID], PurchaseLineInput{
+				ItemID:   suggestion.Item.ID,
+				Quantity: suggestion.SuggestedQuantity,
+			})
+		}
+
+		for _, supplierID := range supplierIDs {
+			order := models.PurchaseOrder{
+				SupplierID: supplierID,
+				Reference:  "replenishment",
+				CreatedBy:  SystemAudit.Actor,
+			}
+			if err := SavePurchaseOrder(tx, &order, lines[supplierID]); err != nil {
+				return err
+			}
+			drafted++
+		}
+		return nil
+	})
+	return drafted, err
+}
+
+// replenishmentSuppliers picks the supplier to order each item from: the preferred
+// one, else the one with the lowest unit cost.
+func replenishmentSuppliers(db *gorm.DB, rows []replenishmentRow) (map[string]*models.ItemSupplier, error) {
+	suppliers := map[string]*models.ItemSupplier{}
+	if len(rows) == 0 {
+		return suppliers, nil
+	}
+
+	ids := make([]string, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+
+	var links []models.ItemSupplier
+	err := db.Preload("Supplier").
+		Where("item_id IN ?", ids).
+		Order("item_id, preferred desc, unit_cost asc").
+		Find(&links).Error
+	if err != nil {
+		return nil, err
+	}
+	for i := range links {
+		if _, ok := suppliers[links[i].ItemID]; !ok {
+			suppliers[links[i].ItemID] = &links[i]
+		}
+	}
+	return suppliers, nil
+}
@@ -0,0 +1,224 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"inventory-service/src/models"
+)
+
+var (
+	ErrReservationNotFound = errors.New("reservation not found")
+	ErrReservationClosed   = errors.New("reservation is no longer active")
+)
+
+// Reserve atomically moves quantity of an item from available to reserved stock.
+// A zero ttl creates a hold that never expires.
+func Reserve(tx *gorm.DB, itemID string, quantity int, ttl time.Duration, reference string) (*models.Reservation, error) {
+	if quantity <= 0 {
+		return nil, fmt.Errorf("%w: quantity must be positive", ErrInvalidMovement)
+	}
+
+	if err := holdStock(tx, itemID, quantity); err != nil {
+		return nil, err
+	}
+
+	reservation := models.Reservation{
+		ItemID:    itemID,
+		Quantity:  quantity,
+		Status:    models.ReservationActive,
+		Reference: reference,
+	}
+	if ttl > 0 {
+		expiresAt := time.Now().Add(ttl)
+		reservation.ExpiresAt = &expiresAt
+	}
+
+	if err := tx.Create(&reservation).Error; err != nil {
+		return nil, err
+	}
+	return &reservation, nil
+}
+
+// CommitReservation turns an active hold into a sale, removing the units from stock.
+func CommitReservation(tx *gorm.DB, id string, actor string) (*models.Reservation, error) {
+	reservation, err := lockActiveReservation(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	// Expired holds are left for the sweeper to release
+	if reservation.ExpiresAt != nil && reservation.ExpiresAt.Before(time.Now()) {
+		return nil, fmt.Errorf("%w: reservation expired", ErrReservationClosed)
+	}
+
+	if err := unholdStock(tx, reservation.ItemID, reservation.Quantity); err != nil {
+		return nil, err
+	}
+
+	_, err = RecordMovement(tx, &models.StockMovement{
+		ItemID:    reservation.ItemID,
+		Delta:     -reservation.Quantity,
+		Reason:    models.MovementSale,
+		Actor:     actor,
+		Reference: "reservation:" + reservation.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reservation.Status = models.ReservationCommitted
+	if err := tx.Save(reservation).Error; err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+// ReleaseReservation returns the units of an active hold to available stock.
+func ReleaseReservation(tx *gorm.DB, id string) (*models.Reservation, error) {
+	reservation, err := lockActiveReservation(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := releaseHold(tx, reservation, models.ReservationReleased); err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+// ExpireReservations releases every active hold whose TTL has passed and reports
+// how many were returned to available stock. Rows locked by another replica's
+// sweep are skipped.
+func ExpireReservations(db *gorm.DB) (int, error) {
+	var ids []string
+	err := db.Model(&models.Reservation{}).
+		Where("status = ? AND expires_at < ?", models.ReservationActive, time.Now()).
+		Order("expires_at").
+		Limit(100).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, id := range ids {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			var reservation models.Reservation
+			result := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+				Where("id = ? AND status = ?", id, models.ReservationActive).
+				Limit(1).Find(&reservation)
+			if result.Error != nil || result.RowsAffected == 0 {
+				return result.Error
+			}
+			if err := releaseHold(tx, &reservation, models.ReservationExpired); err != nil {
+				return err
+			}
+			expired++
+			return nil
+		})
+		if err != nil {
+			return expired, err
+		}
+	}
+	return expired, nil
+}
+
+func lockActiveReservation(tx *gorm.DB, id string) (*models.Reservation, error) {
+	if uuid.Validate(id) != nil {
+		return nil, ErrReservationNotFound
+	}
+	var reservation models.Reservation
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&reservation, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrReservationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if reservation.Status != models.ReservationActive {
+		return nil, fmt.Errorf("%w: reservation is %s", ErrReservationClosed, reservation.Status)
+	}
+	return &reservation, nil
+}
+
+func releaseHold(tx *gorm.DB, reservation *models.Reservation, status string) error {
+	if err := unreserve(tx, reservation); err != nil {
+		return err
+	}
+	reservation.Status = status
+	return tx.Save(reservation).Error
+}
+
+func unreserve(tx *gorm.DB, reservation *models.Reservation) error {
+	return releaseStock(tx, reservation.ItemID, reservation.Quantity)
+}
+
+// holdStock moves quantity of an item from available to reserved stock. Like
+// releaseStock, it emits a stock.reserved event.
+func holdStock(tx *gorm.DB, itemID string, quantity int) error {
+	if uuid.Validate(itemID) != nil {
+		return ErrItemNotFound
+	}
+	// Conditional update so concurrent holds can never oversell
+	result := tx.Model(&models.Item{}).
+		Where("id = ? AND stock - reserved >= ?", itemID, quantity).
+		Updates(map[string]interface{}{"reserved": gorm.Expr("reserved + ?", quantity), "version": nextVersion})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		var count int64
+		if err := tx.Model(&models.Item{}).Where("id = ?", itemID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return ErrItemNotFound
+		}
+		return ErrInsufficientStock
+	}
+	return recordReservedChange(tx, itemID, quantity)
+}
+
+// releaseStock returns held units to available stock. It also applies to
+// soft-deleted items so their holds stay consistent if restored.
+func releaseStock(tx *gorm.DB, itemID string, quantity int) error {
+	if err := unholdStock(tx, itemID, quantity); err != nil {
+		return err
+	}
+	return recordReservedChange(tx, itemID, -quantity)
+}
+
+// unholdStock drops held units without emitting an event, for holds consumed by a
+// sale movement in the same transaction: its stock.changed event and alert
+// evaluation see the final reserved stock, so available stock does not appear to
+// rise and fall again.
+func unholdStock(tx *gorm.DB, itemID string, quantity int) error {
+	return tx.Unscoped().Model(&models.Item{}).
+		Where("id = ?", itemID).
+		Updates(map[string]interface{}{"reserved": gorm.Expr("reserved - ?", quantity), "version": nextVersion}).Error
+}
+
+// recordReservedChange emits a stock.reserved event with the item's stock after
+// its reserved units changed by delta and evaluates its stock alert rules.
+func recordReservedChange(tx *gorm.DB, itemID string, delta int) error {
+	var item models.Item
+	if err := tx.Unscoped().First(&item, "id = ?", itemID).Error; err != nil {
+		return err
+	}
+	change := ReservedChange{
+		SKU:       item.SKU,
+		Name:      item.Name,
+		Delta:     delta,
+		Stock:     item.Stock,
+		Reserved:  item.Reserved,
+		Available: item.Stock - item.Reserved,
+	}
+	if err := RecordItemEvent(tx, SystemAudit, models.EventStockReserved, &item, change); err != nil {
+		return err
+	}
+	return EvaluateAlerts(tx, SystemAudit, &item)
+}
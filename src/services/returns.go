@@ -0,0 +1,332 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"inventory-service/src/models"
+)
+
+var (
+	ErrReturnNotFound = errors.New("return authorization not found")
+	ErrInvalidReturn  = errors.New("invalid return")
+	ErrReturnStatus   = errors.New("return authorization does not allow this in its current status")
+)
+
+// ReturnLineInput is a requested return authorization line.
+type ReturnLineInput struct {
+	ItemID   string
+	Quantity int
+}
+
+// DispositionInput is a quantity of one return line and what to do with it.
+type DispositionInput struct {
+	LineID      string
+	Quantity    int
+	Disposition string
+	Note        string
+}
+
+// CreateReturn authorizes the return of units. Against a sales order, each item
+// must have been shipped on it and no more units may be authorized than shipped,
+// counting earlier returns that were not cancelled.
+func CreateReturn(tx *gorm.DB, rma *models.ReturnAuthorization, inputs []ReturnLineInput) error {
+	if len(inputs) == 0 {
+		return fmt.Errorf("%w: at least one line is required", ErrInvalidReturn)
+	}
+
+	var shipped map[string]int
+	if rma.SalesOrderID != nil {
+		var err error
+		if shipped, err = returnableUnits(tx, *rma.SalesOrderID); err != nil {
+			return err
+		}
+	}
+
+	seen := map[string]bool{}
+	lines := make([]models.ReturnLine, 0, len(inputs))
+	for i, input := range inputs {
+		if input.Quantity <= 0 {
+			return fmt.Errorf("%w: quantity must be positive", ErrInvalidReturn)
+		}
+		if seen[input.ItemID] {
+			return fmt.Errorf("%w: item %s appears on more than one line", ErrInvalidReturn, input.ItemID)
+		}
+		seen[input.ItemID] = true
+
+		var item models.Item
+		if uuid.Validate(input.ItemID) != nil {
+			return fmt.Errorf("%w: %s", ErrItemNotFound, input.ItemID)
+		}
+		if err := tx.First(&item, "id = ?", input.ItemID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w: %s", ErrItemNotFound, input.ItemID)
+			}
+			return err
+		}
+		if shipped != nil && input.Quantity > shipped[item.ID] {
+			return fmt.Errorf("%w: only %d units of %s can be returned against this sales order", ErrInvalidReturn, shipped[item.ID], item.SKU)
+		}
+		lines = append(lines, models.ReturnLine{ItemID: item.ID, Position: i + 1, Quantity: input.Quantity})
+	}
+
+	rma.Status = models.ReturnAuthorized
+	if err := tx.Omit("Lines", "Dispositions").Create(rma).Error; err != nil {
+		return err
+	}
+	for i := range lines {
+		lines[i].ReturnAuthorizationID = rma.ID
+	}
+	if err := tx.Create(&lines).Error; err != nil {
+		return err
+	}
+
+	rma.Lines = lines
+	return nil
+}
+
+// ReceiveReturn books returned units against their lines. Restocked units go back
+// into sellable stock with a return movement at locationID, quarantined units are
+// held on the item apart from stock, and scrapped units are only recorded.
+func ReceiveReturn(tx *gorm.DB, id string, inputs []DispositionInput, locationID *string, actor string) (*models.ReturnAuthorization, error) {
+	rma, err := lockReturn(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rma.Status != models.ReturnAuthorized && rma.Status != models.ReturnPartiallyReceived {
+		return nil, fmt.Errorf("%w: return is %s", ErrReturnStatus, rma.Status)
+	}
+
+	lines := returnLinesByID(rma)
+	for _, input := range inputs {
+		line, ok := lines[input.LineID]
+		if !ok {
+			return nil, fmt.Errorf("%w: line %s is not on this return", ErrInvalidReturn, input.LineID)
+		}
+		if !models.ValidDisposition(input.Disposition) {
+			return nil, fmt.Errorf("%w: disposition must be restock, quarantine or scrap", ErrInvalidReturn)
+		}
+		if input.Quantity <= 0 || input.Quantity > line.Outstanding() {
+			return nil, fmt.Errorf("%w: line %s has %d units outstanding", ErrInvalidReturn, line.ID, line.Outstanding())
+		}
+
+		line.QuantityReceived += input.Quantity
+		switch input.Disposition {
+		case models.DispositionRestock:
+			line.QuantityRestocked += input.Quantity
+			err = restockReturn(tx, rma, line, input.Quantity, locationID, actor)
+		case models.DispositionQuarantine:
+			line.QuantityQuarantined += input.Quantity
+			err = adjustQuarantine(tx, line.ItemID, input.Quantity)
+		case models.DispositionScrap:
+			line.QuantityScrapped += input.Quantity
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := recordDisposition(tx, rma, line, input, false, locationID, actor); err != nil {
+			return nil, err
+		}
+	}
+
+	rma.Status = models.ReturnReceived
+	for _, line := range rma.Lines {
+		if line.Outstanding() > 0 {
+			rma.Status = models.ReturnPartiallyReceived
+			break
+		}
+	}
+	if rma.Status == models.ReturnReceived {
+		now := time.Now()
+		rma.ReceivedAt = &now
+	}
+	return rma, saveReturn(tx, rma)
+}
+
+// ReleaseQuarantine settles quarantined units of a return by restocking them at
+// locationID or scrapping them.
+func ReleaseQuarantine(tx *gorm.DB, id string, inputs []DispositionInput, locationID *string, actor string) (*models.ReturnAuthorization, error) {
+	rma, err := lockReturn(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := returnLinesByID(rma)
+	for _, input := range inputs {
+		line, ok := lines[input.LineID]
+		if !ok {
+			return nil, fmt.Errorf("%w: line %s is not on this return", ErrInvalidReturn, input.LineID)
+		}
+		if input.Disposition != models.DispositionRestock && input.Disposition != models.DispositionScrap {
+			return nil, fmt.Errorf("%w: quarantined units can only be restocked or scrapped", ErrInvalidReturn)
+		}
+		if input.Quantity <= 0 || input.Quantity > line.QuantityQuarantined {
+			return nil, fmt.Errorf("%w: line %s has %d units in quarantine", ErrInvalidReturn, line.ID, line.QuantityQuarantined)
+		}
+
+		line.QuantityQuarantined -= input.Quantity
+		if err := adjustQuarantine(tx, line.ItemID, -input.Quantity); err != nil {
+			return nil, err
+		}
+		if input.Disposition == models.DispositionRestock {
+			line.QuantityRestocked += input.Quantity
+			if err := restockReturn(tx, rma, line, input.Quantity, locationID, actor); err != nil {
+				return nil, err
+			}
+		} else {
+			line.QuantityScrapped += input.Quantity
+		}
+		if err := recordDisposition(tx, rma, line, input, true, locationID, actor); err != nil {
+			return nil, err
+		}
+	}
+
+	return rma, saveReturn(tx, rma)
+}
+
+// CancelReturn withdraws an authorization nothing has been received against.
+func CancelReturn(tx *gorm.DB, id string) (*models.ReturnAuthorization, error) {
+	rma, err := lockReturn(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rma.Status != models.ReturnAuthorized {
+		return nil, fmt.Errorf("%w: only returns with nothing received can be cancelled, return is %s", ErrReturnStatus, rma.Status)
+	}
+
+	now := time.Now()
+	rma.Status = models.ReturnCancelled
+	rma.CancelledAt = &now
+	return rma, saveReturn(tx, rma)
+}
+
+// returnableUnits returns, per item, the units shipped on a sales order less those
+// already authorized for return against it.
+func returnableUnits(tx *gorm.DB, salesOrderID string) (map[string]int, error) {
+	if uuid.Validate(salesOrderID) != nil {
+		return nil, ErrSalesOrderNotFound
+	}
+	// Locking the order serializes concurrent returns against it
+	var order models.SalesOrder
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Preload("Lines").First(&order, "id = ?", salesOrderID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSalesOrderNotFound
+		}
+		return nil, err
+	}
+
+	units := map[string]int{}
+	for _, line := range order.Lines {
+		units[line.ItemID] = line.QuantityShipped
+	}
+
+	var returned []struct {
+		ItemID   string
+		Quantity int
+	}
+	err = tx.Model(&models.ReturnLine{}).
+		Select("return_lines.item_id, SUM(return_lines.quantity) AS quantity").
+		Joins("JOIN return_authorizations ON return_authorizations.id = return_lines.return_authorization_id").
+		Where("return_authorizations.sales_order_id = ? AND return_authorizations.status <> ?", salesOrderID, models.ReturnCancelled).
+		Group("return_lines.item_id").
+		Scan(&returned).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range returned {
+		units[r.ItemID] -= r.Quantity
+	}
+	return units, nil
+}
+
+func restockReturn(tx *gorm.DB, rma *models.ReturnAuthorization, line *models.ReturnLine, quantity int, locationID *string, actor string) error {
+	_, err := RecordMovement(tx, &models.StockMovement{
+		ItemID:     line.ItemID,
+		LocationID: locationID,
+		Delta:      quantity,
+		Reason:     models.MovementReturn,
+		Actor:      actor,
+		Reference:  "return:" + rma.ID,
+	})
+	return err
+}
+
+func recordDisposition(tx *gorm.DB, rma *models.ReturnAuthorization, line *models.ReturnLine, input DispositionInput, fromQuarantine bool, locationID *string, actor string) error {
+	disposition := models.ReturnDisposition{
+		ReturnAuthorizationID: rma.ID,
+		ReturnLineID:          line.ID,
+		ItemID:                line.ItemID,
+		Quantity:              input.Quantity,
+		Disposition:           input.Disposition,
+		FromQuarantine:        fromQuarantine,
+		Note:                  input.Note,
+		Actor:                 actor,
+	}
+	if input.Disposition == models.DispositionRestock {
+		disposition.LocationID = locationID
+	}
+	if err := tx.Create(&disposition).Error; err != nil {
+		return err
+	}
+	rma.Dispositions = append(rma.Dispositions, disposition)
+
+	return tx.Model(line).Updates(map[string]interface{}{
+		"quantity_received":    line.QuantityReceived,
+		"quantity_restocked":   line.QuantityRestocked,
+		"quantity_quarantined": line.QuantityQuarantined,
+		"quantity_scrapped":    line.QuantityScrapped,
+	}).Error
+}
+
+// adjustQuarantine changes the units held in quarantine on an item, refusing to
+// take it below zero.
+func adjustQuarantine(tx *gorm.DB, itemID string, delta int) error {
+	result := tx.Model(&models.Item{}).
+		Where("id = ? AND quarantined + ? >= 0", itemID, delta).
+		Updates(map[string]interface{}{"quarantined": gorm.Expr("quarantined + ?", delta), "version": nextVersion})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrItemNotFound
+	}
+	return nil
+}
+
+func returnLinesByID(rma *models.ReturnAuthorization) map[string]*models.ReturnLine {
+	lines := map[string]*models.ReturnLine{}
+	for i := range rma.Lines {
+		lines[rma.Lines[i].ID] = &rma.Lines[i]
+	}
+	return lines
+}
+
+func saveReturn(tx *gorm.DB, rma *models.ReturnAuthorization) error {
+	return tx.Omit("Lines", "Dispositions").Save(rma).Error
+}
+
+func lockReturn(tx *gorm.DB, id string) (*models.ReturnAuthorization, error) {
+	if uuid.Validate(id) != nil {
+		return nil, ErrReturnNotFound
+	}
+	var rma models.ReturnAuthorization
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&rma, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrReturnNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Where("return_authorization_id = ?", rma.ID).Order("position asc").Find(&rma.Lines).Error
+	if err != nil {
+		return nil, err
+	}
+	return &rma, nil
+}
@@ -0,0 +1,259 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"inventory-service/src/models"
+)
+
+var (
+	ErrSalesOrderNotFound = errors.New("sales order not found")
+	ErrInvalidSalesOrder  = errors.New("invalid sales order")
+	ErrSalesOrderStatus   = errors.New("sales order does not allow this in its current status")
+)
+
+// SalesLineInput is a requested sales order line.
+type SalesLineInput struct {
+	ItemID   string
+	Quantity int
+}
+
+// CreateSalesOrder creates a pending sales order. No stock is held until it is
+// allocated.
+func CreateSalesOrder(tx *gorm.DB, order *models.SalesOrder, inputs []SalesLineInput) error {
+	if len(inputs) == 0 {
+		return fmt.Errorf("%w: at least one line is required", ErrInvalidSalesOrder)
+	}
+
+	seen := map[string]bool{}
+	lines := make([]models.SalesOrderLine, 0, len(inputs))
+	for i, input := range inputs {
+		if input.Quantity <= 0 {
+			return fmt.Errorf("%w: quantity must be positive", ErrInvalidSalesOrder)
+		}
+		if seen[input.ItemID] {
+			return fmt.Errorf("%w: item %s appears on more than one line", ErrInvalidSalesOrder, input.ItemID)
+		}
+		seen[input.ItemID] = true
+
+		var item models.Item
+		if err := tx.First(&item, "id = ?", input.ItemID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w: %s", ErrItemNotFound, input.ItemID)
+			}
+			return err
+		}
+		lines = append(lines, models.SalesOrderLine{ItemID: item.ID, Position: i + 1, Quantity: input.Quantity})
+	}
+
+	order.Status = models.SalesPending
+	if err := tx.Omit("Lines").Create(order).Error; err != nil {
+		return err
+	}
+	for i := range lines {
+		lines[i].SalesOrderID = order.ID
+	}
+	if err := tx.Create(&lines).Error; err != nil {
+		return err
+	}
+
+	order.Lines = lines
+	return nil
+}
+
+// AllocateSalesOrder reserves available stock for every unit not yet allocated or
+// shipped. Lines that cannot be filled completely keep the rest on backorder and
+// can be allocated again once stock arrives.
+func AllocateSalesOrder(tx *gorm.DB, id string) (*models.SalesOrder, error) {
+	order, err := lockOpenSalesOrder(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range byItem(order.Lines) {
+		if line.Backordered() == 0 {
+			continue
+		}
+		item, err := lockItem(tx, line.ItemID)
+		if err != nil {
+			return nil, err
+		}
+		take := min(line.Backordered(), item.Stock-item.Reserved)
+		if take <= 0 {
+			continue
+		}
+		if err := holdStock(tx, item.ID, take); err != nil {
+			return nil, err
+		}
+		line.QuantityAllocated += take
+		if err := tx.Model(line).Update("quantity_allocated", line.QuantityAllocated).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	return order, saveSalesStatus(tx, order)
+}
+
+// PickSalesOrder stages every allocated unit for shipment.
+func PickSalesOrder(tx *gorm.DB, id string) (*models.SalesOrder, error) {
+	order, err := lockOpenSalesOrder(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	picked := false
+	for i := range order.Lines {
+		line := &order.Lines[i]
+		if line.QuantityPicked == line.QuantityAllocated {
+			continue
+		}
+		line.QuantityPicked = line.QuantityAllocated
+		if err := tx.Model(line).Update("quantity_picked", line.QuantityPicked).Error; err != nil {
+			return nil, err
+		}
+		picked = true
+	}
+	if !picked {
+		return nil, fmt.Errorf("%w: no allocated units left to pick", ErrSalesOrderStatus)
+	}
+
+	return order, saveSalesStatus(tx, order)
+}
+
+// ShipSalesOrder ships every picked unit, releasing its hold and recording a sale
+// movement that removes it from stock.
+func ShipSalesOrder(tx *gorm.DB, id string, actor string) (*models.SalesOrder, error) {
+	order, err := lockOpenSalesOrder(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	shipped := false
+	for _, line := range byItem(order.Lines) {
+		if line.QuantityPicked == 0 {
+			continue
+		}
+		if err := unholdStock(tx, line.ItemID, line.QuantityPicked); err != nil {
+			return nil, err
+		}
+		_, err := RecordMovement(tx, &models.StockMovement{
+			ItemID:    line.ItemID,
+			Delta:     -line.QuantityPicked,
+			Reason:    models.MovementSale,
+			Actor:     actor,
+			Reference: "sales_order:" + order.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		line.QuantityShipped += line.QuantityPicked
+		line.QuantityAllocated -= line.QuantityPicked
+		line.QuantityPicked = 0
+		err = tx.Model(line).Updates(map[string]interface{}{
+			"quantity_shipped":   line.QuantityShipped,
+			"quantity_allocated": line.QuantityAllocated,
+			"quantity_picked":    0,
+		}).Error
+		if err != nil {
+			return nil, err
+		}
+		shipped = true
+	}
+	if !shipped {
+		return nil, fmt.Errorf("%w: nothing has been picked", ErrSalesOrderStatus)
+	}
+
+	return order, saveSalesStatus(tx, order)
+}
+
+// CancelSalesOrder returns every allocated unit to available stock and closes the
+// order. Units already shipped are not affected.
+func CancelSalesOrder(tx *gorm.DB, id string) (*models.SalesOrder, error) {
+	order, err := lockOpenSalesOrder(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range byItem(order.Lines) {
+		if line.QuantityAllocated == 0 {
+			continue
+		}
+		if err := releaseStock(tx, line.ItemID, line.QuantityAllocated); err != nil {
+			return nil, err
+		}
+		line.QuantityAllocated = 0
+		line.QuantityPicked = 0
+		err := tx.Model(line).Updates(map[string]interface{}{"quantity_allocated": 0, "quantity_picked": 0}).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	order.Status = models.SalesCancelled
+	order.CancelledAt = &now
+	if err := tx.Omit("Lines").Save(order).Error; err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// saveSalesStatus derives the order status from its lines.
+func saveSalesStatus(tx *gorm.DB, order *models.SalesOrder) error {
+	status := models.SalesShipped
+	for _, line := range order.Lines {
+		switch {
+		case line.QuantityPicked > 0:
+			status = models.SalesPicked
+		case line.Backordered() > 0 && status != models.SalesPicked:
+			status = models.SalesBackordered
+		case line.QuantityAllocated > 0 && status == models.SalesShipped:
+			status = models.SalesAllocated
+		}
+	}
+
+	order.Status = status
+	if status == models.SalesShipped {
+		now := time.Now()
+		order.ShippedAt = &now
+	}
+	return tx.Omit("Lines").Save(order).Error
+}
+
+// byItem returns the lines ordered by item so concurrent orders lock item rows in
+// the same order.
+func byItem(lines []models.SalesOrderLine) []*models.SalesOrderLine {
+	sorted := make([]*models.SalesOrderLine, len(lines))
+	for i := range lines {
+		sorted[i] = &lines[i]
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ItemID < sorted[j].ItemID })
+	return sorted
+}
+
+func lockOpenSalesOrder(tx *gorm.DB, id string) (*models.SalesOrder, error) {
+	var order models.SalesOrder
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrSalesOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if order.Status == models.SalesShipped || order.Status == models.SalesCancelled {
+		return nil, fmt.Errorf("%w: order is %s", ErrSalesOrderStatus, order.Status)
+	}
+
+	err = tx.Where("sales_order_id = ?", order.ID).Order("position asc").Find(&order.Lines).Error
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
@@ -0,0 +1,72 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+
+	"gorm.io/gorm"
+)
+
+// errorStatuses maps service errors onto HTTP status codes.
+var errorStatuses = []struct {
+	err    error
+	status int
+}{
+	{gorm.ErrRecordNotFound, http.StatusNotFound},
+	{ErrItemNotFound, http.StatusNotFound},
+	{ErrReservationNotFound, http.StatusNotFound},
+	{ErrLocationNotFound, http.StatusNotFound},
+	{ErrTransferNotFound, http.StatusNotFound},
+	{ErrCategoryNotFound, http.StatusNotFound},
+	{ErrAttributeSchemaNotFound, http.StatusNotFound},
+	{ErrSupplierNotFound, http.StatusNotFound},
+	{ErrItemSupplierNotFound, http.StatusNotFound},
+	{ErrPurchaseOrderNotFound, http.StatusNotFound},
+	{ErrSalesOrderNotFound, http.StatusNotFound},
+	{ErrReturnNotFound, http.StatusNotFound},
+	{ErrAlertRuleNotFound, http.StatusNotFound},
+	{ErrWebhookNotFound, http.StatusNotFound},
+	{ErrWebhookDeliveryNotFound, http.StatusNotFound},
+	{ErrItemNotDeleted, http.StatusNotFound},
+	{ErrInvalidMovement, http.StatusBadRequest},
+	{ErrInvalidTransfer, http.StatusBadRequest},
+	{ErrInvalidPrice, http.StatusBadRequest},
+	{ErrInvalidIdentifier, http.StatusBadRequest},
+	{ErrCategoryCycle, http.StatusBadRequest},
+	{ErrInvalidAttributes, http.StatusBadRequest},
+	{ErrInvalidPurchaseOrder, http.StatusBadRequest},
+	{ErrInvalidSalesOrder, http.StatusBadRequest},
+	{ErrInvalidReturn, http.StatusBadRequest},
+	{ErrInvalidReorderPolicy, http.StatusBadRequest},
+	{ErrInvalidAlertRule, http.StatusBadRequest},
+	{ErrInvalidWebhook, http.StatusBadRequest},
+	{ErrInsufficientStock, http.StatusConflict},
+	{ErrReservationClosed, http.StatusConflict},
+	{ErrLocationInUse, http.StatusConflict},
+	{ErrTransferClosed, http.StatusConflict},
+	{ErrIdentifierInUse, http.StatusConflict},
+	{ErrCategoryExists, http.StatusConflict},
+	{ErrCategoryInUse, http.StatusConflict},
+	{ErrAttributeSchemaInUse, http.StatusConflict},
+	{ErrSupplierCurrencyLocked, http.StatusConflict},
+	{ErrSupplierInUse, http.StatusConflict},
+	{ErrPurchaseOrderStatus, http.StatusConflict},
+	{ErrOverReceipt, http.StatusConflict},
+	{ErrSalesOrderStatus, http.StatusConflict},
+	{ErrReturnStatus, http.StatusConflict},
+	{ErrItemHeld, http.StatusConflict},
+	{ErrItemReferenced, http.StatusConflict},
+	{gorm.ErrDuplicatedKey, http.StatusConflict},
+	{gorm.ErrForeignKeyViolated, http.StatusConflict},
+}
+
+// ErrorStatus returns the HTTP status code of the first service error err matches,
+// or 500. The REST and gRPC APIs both derive their status codes from it.
+func ErrorStatus(err error) int {
+	for _, candidate := range errorStatuses {
+		if errors.Is(err, candidate.err) {
+			return candidate.status
+		}
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"inventory-service/src/models"
+)
+
+// nextVersion bumps an item's optimistic-concurrency version; every write to an
+// item row includes it so ETags change whenever the representation does.
+var nextVersion = gorm.Expr("version + 1")
+
+var (
+	ErrItemNotFound      = errors.New("item not found")
+	ErrInsufficientStock = errors.New("insufficient stock")
+	ErrInvalidMovement   = errors.New("invalid stock movement")
+)
+
+// RecordMovement appends a movement to the ledger and applies its delta to the item
+// and its per-location quantities, snapshotting the new state into the item's
+// version history, emitting a stock.changed event and evaluating stock alert rules.
+// It must run inside a transaction so the ledger entry and the stock change commit
+// together; the item row is locked for the rest of that transaction.
+func RecordMovement(tx *gorm.DB, movement *models.StockMovement) (*models.Item, error) {
+	if movement.Delta == 0 {
+		return nil, fmt.Errorf("%w: delta must not be zero", ErrInvalidMovement)
+	}
+	if !models.ValidMovementReason(movement.Reason) {
+		return nil, fmt.Errorf("%w: unknown reason %q", ErrInvalidMovement, movement.Reason)
+	}
+	switch sign := models.MovementSign(movement.Reason); {
+	case sign > 0 && movement.Delta < 0:
+		return nil, fmt.Errorf("%w: %s delta must be positive", ErrInvalidMovement, movement.Reason)
+	case sign < 0 && movement.Delta > 0:
+		return nil, fmt.Errorf("%w: %s delta must be negative", ErrInvalidMovement, movement.Reason)
+	}
+
+	item, err := lockItem(tx, movement.ItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Outbound movements may only consume stock that is not held by a reservation
+	if movement.Delta < 0 && item.Stock+movement.Delta < item.Reserved {
+		return nil, ErrInsufficientStock
+	}
+	item.Stock += movement.Delta
+
+	if err := applyLocationDelta(tx, item.ID, movement.LocationID, movement.Delta); err != nil {
+		return nil, err
+	}
+
+	item.Version++
+	if err := tx.Model(item).Updates(map[string]interface{}{"stock": item.Stock, "version": nextVersion}).Error; err != nil {
+		return nil, err
+	}
+
+	movement.BalanceAfter = item.Stock
+	if err := tx.Create(movement).Error; err != nil {
+		return nil, err
+	}
+	if err := SnapshotItem(tx, item, false); err != nil {
+		return nil, err
+	}
+	change := StockChange{
+		SKU:       item.SKU,
+		Name:      item.Name,
+		Movement:  *movement,
+		Stock:     item.Stock,
+		Reserved:  item.Reserved,
+		Available: item.Stock - item.Reserved,
+	}
+	audit := AuditContext{Actor: movement.Actor}
+	if err := RecordItemEvent(tx, audit, models.EventStockChanged, item, change); err != nil {
+		return nil, err
+	}
+	if err := EvaluateAlerts(tx, audit, item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// ReconcileLedger records an adjustment for every item whose stock does not match
+// the sum of its ledger, so that rows written before the ledger existed (or by hand)
+// are explained by at least one movement.
+func ReconcileLedger(db *gorm.DB) error {
+	type drift struct {
+		ID     string
+		Stock  int
+		Ledger int
+	}
+
+	var drifts []drift
+	err := db.Table("items").
+		Select("items.id, items.stock, COALESCE(SUM(stock_movements.delta), 0) AS ledger").
+		Joins("LEFT JOIN stock_movements ON stock_movements.item_id = items.id").
+		Group("items.id, items.stock").
+		Having("items.stock <> COALESCE(SUM(stock_movements.delta), 0)").
+		Scan(&drifts).Error
+	if err != nil {
+		return err
+	}
+
+	for _, d := range drifts {
+		movement := models.StockMovement{
+			ItemID:       d.ID,
+			Delta:        d.Stock - d.Ledger,
+			Reason:       models.MovementAdjustment,
+			Actor:        "system",
+			Reference:    "ledger reconciliation",
+			BalanceAfter: d.Stock,
+		}
+		if err := db.Create(&movement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lockItem loads an item FOR UPDATE. An ID that is not a UUID cannot name an item,
+// so it is not found rather than left to fail the uuid cast in Postgres.
+func lockItem(tx *gorm.DB, id string) (*models.Item, error) {
+	if uuid.Validate(id) != nil {
+		return nil, ErrItemNotFound
+	}
+	var item models.Item
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&item, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrItemNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
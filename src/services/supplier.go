@@ -0,0 +1,83 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"inventory-service/src/models"
+)
+
+var (
+	ErrSupplierNotFound     = errors.New("supplier not found")
+	ErrItemSupplierNotFound = errors.New("item is not linked to this supplier")
+	// Unit costs are quoted in the supplier's currency, so it is fixed once items are linked
+	ErrSupplierCurrencyLocked = errors.New("supplier currency cannot change while items are linked")
+	ErrSupplierInUse          = errors.New("supplier has purchase orders")
+)
+
+// SaveItemSupplier creates or replaces the link between an item and a supplier.
+// Marking it preferred clears the flag on the item's other suppliers.
+func SaveItemSupplier(tx *gorm.DB, link *models.ItemSupplier) error {
+	if _, err := lockItem(tx, link.ItemID); err != nil {
+		return err
+	}
+
+	var supplier models.Supplier
+	if err := tx.First(&supplier, "id = ?", link.SupplierID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrSupplierNotFound
+		}
+		return err
+	}
+	if err := ValidatePrice(link.UnitCost, supplier.Currency); err != nil {
+		return fmt.Errorf("unit cost: %w", err)
+	}
+
+	if link.Preferred {
+		err := tx.Model(&models.ItemSupplier{}).
+			Where("item_id = ? AND supplier_id <> ? AND preferred", link.ItemID, link.SupplierID).
+			Update("preferred", false).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "item_id"}, {Name: "supplier_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"supplier_sku", "unit_cost", "min_order_quantity", "preferred", "updated_at"}),
+	}).Create(link).Error
+	if err != nil {
+		return err
+	}
+
+	link.Supplier = &supplier
+	return nil
+}
+
+// DeleteSupplier removes a supplier together with its item links. Suppliers that
+// have purchase orders are kept for their history.
+func DeleteSupplier(tx *gorm.DB, id string) error {
+	var supplier models.Supplier
+	if err := tx.First(&supplier, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrSupplierNotFound
+		}
+		return err
+	}
+
+	var orders int64
+	if err := tx.Model(&models.PurchaseOrder{}).Where("supplier_id = ?", id).Count(&orders).Error; err != nil {
+		return err
+	}
+	if orders > 0 {
+		return ErrSupplierInUse
+	}
+
+	if err := tx.Where("supplier_id = ?", id).Delete(&models.ItemSupplier{}).Error; err != nil {
+		return err
+	}
+	return tx.Delete(&supplier).Error
+}
@@ -0,0 +1,146 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"inventory-service/src/models"
+)
+
+var (
+	ErrTransferNotFound = errors.New("transfer order not found")
+	ErrTransferClosed   = errors.New("transfer order is closed")
+	ErrInvalidTransfer  = errors.New("invalid transfer order")
+)
+
+// ShipTransfer creates a transfer order and ships its units out of the source
+// location, holding them as in-transit on the item.
+func ShipTransfer(tx *gorm.DB, transfer *models.TransferOrder) error {
+	if transfer.Quantity <= 0 {
+		return fmt.Errorf("%w: quantity must be positive", ErrInvalidTransfer)
+	}
+	if transfer.FromLocationID == transfer.ToLocationID {
+		return fmt.Errorf("%w: source and destination must differ", ErrInvalidTransfer)
+	}
+
+	var destination models.Location
+	if err := tx.First(&destination, "id = ?", transfer.ToLocationID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrLocationNotFound
+		}
+		return err
+	}
+
+	transfer.Status = models.TransferInTransit
+	if err := tx.Create(transfer).Error; err != nil {
+		return err
+	}
+
+	_, err := RecordMovement(tx, &models.StockMovement{
+		ItemID:     transfer.ItemID,
+		LocationID: &transfer.FromLocationID,
+		Delta:      -transfer.Quantity,
+		Reason:     models.MovementTransferOut,
+		Actor:      transfer.CreatedBy,
+		Reference:  "transfer:" + transfer.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return adjustInTransit(tx, transfer.ItemID, transfer.Quantity)
+}
+
+// ReceiveTransfer books quantity in-transit units into the destination location.
+func ReceiveTransfer(tx *gorm.DB, id string, quantity int, actor string) (*models.TransferOrder, error) {
+	transfer, err := lockOpenTransfer(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if quantity <= 0 || quantity > transfer.Outstanding() {
+		return nil, fmt.Errorf("%w: quantity must be between 1 and %d", ErrInvalidTransfer, transfer.Outstanding())
+	}
+
+	_, err = RecordMovement(tx, &models.StockMovement{
+		ItemID:     transfer.ItemID,
+		LocationID: &transfer.ToLocationID,
+		Delta:      quantity,
+		Reason:     models.MovementTransferIn,
+		Actor:      actor,
+		Reference:  "transfer:" + transfer.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := adjustInTransit(tx, transfer.ItemID, -quantity); err != nil {
+		return nil, err
+	}
+
+	transfer.QuantityReceived += quantity
+	transfer.Status = models.TransferPartiallyReceived
+	if transfer.Outstanding() == 0 {
+		now := time.Now()
+		transfer.Status = models.TransferReceived
+		transfer.ReceivedAt = &now
+	}
+	if err := tx.Save(transfer).Error; err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
+
+// CancelTransfer returns every unit still in transit to the source location.
+func CancelTransfer(tx *gorm.DB, id string, actor string) (*models.TransferOrder, error) {
+	transfer, err := lockOpenTransfer(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	outstanding := transfer.Outstanding()
+	_, err = RecordMovement(tx, &models.StockMovement{
+		ItemID:     transfer.ItemID,
+		LocationID: &transfer.FromLocationID,
+		Delta:      outstanding,
+		Reason:     models.MovementTransferIn,
+		Actor:      actor,
+		Reference:  "transfer:" + transfer.ID + " cancelled",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := adjustInTransit(tx, transfer.ItemID, -outstanding); err != nil {
+		return nil, err
+	}
+
+	transfer.QuantityReturned += outstanding
+	transfer.Status = models.TransferCancelled
+	if err := tx.Save(transfer).Error; err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
+
+func lockOpenTransfer(tx *gorm.DB, id string) (*models.TransferOrder, error) {
+	var transfer models.TransferOrder
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&transfer, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrTransferNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if transfer.Outstanding() == 0 {
+		return nil, fmt.Errorf("%w: transfer is %s", ErrTransferClosed, transfer.Status)
+	}
+	return &transfer, nil
+}
+
+func adjustInTransit(tx *gorm.DB, itemID string, delta int) error {
+	return tx.Model(&models.Item{}).
+		Where("id = ?", itemID).
+		Updates(map[string]interface{}{"in_transit": gorm.Expr("in_transit + ?", delta), "version": nextVersion}).Error
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"inventory-service/src/models"
+)
+
+var (
+	ErrItemNotDeleted = errors.New("item is not in the trash")
+	ErrItemHeld       = errors.New("item has reserved or in-transit stock")
+	ErrItemReferenced = errors.New("item is referenced by stock movements, orders or returns")
+)
+
+// RestoreItem brings a soft-deleted item back into the inventory.
+func RestoreItem(tx *gorm.DB, id string, audit AuditContext) (*models.Item, error) {
+	item, err := lockTrashedItem(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	before := *item
+
+	item.DeletedAt = gorm.DeletedAt{}
+	item.Version++
+	err = tx.Unscoped().Model(item).
+		Updates(map[string]interface{}{"deleted_at": nil, "version": nextVersion}).Error
+	if err != nil {
+		return nil, err
+	}
+	if err := SnapshotItem(tx, item, false); err != nil {
+		return nil, err
+	}
+	// Consumers dropped the item when it was deleted, so it comes back as created
+	if err := RecordItemEvent(tx, audit, models.EventItemCreated, item, item); err != nil {
+		return nil, err
+	}
+
+	if err := RecordAudit(tx, audit, models.AuditRestore, &before, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// Records that reference an item but belong to something else: the append-only
+// ledger, transfers, orders and returns. An item they reference cannot be purged.
+var itemReferences = []struct {
+	model interface{}
+	name  string
+}{
+	{&models.StockMovement{}, "stock movements"},
+	{&models.TransferOrder{}, "transfer orders"},
+	{&models.PurchaseOrderLine{}, "purchase order lines"},
+	{&models.SalesOrderLine{}, "sales order lines"},
+	{&models.ReturnLine{}, "return lines"},
+	{&models.ReturnDisposition{}, "return dispositions"},
+}
+
+// PurgeItem permanently removes a soft-deleted item together with the data that
+// belongs to it alone: stock levels, closed reservations, supplier links, alert
+// rules and alert state. Items still referenced by the ledger, a transfer, an
+// order or a return are refused. Its audit, version and alert history are kept.
+func PurgeItem(tx *gorm.DB, id string, audit AuditContext) error {
+	item, err := lockTrashedItem(tx, id)
+	if err != nil {
+		return err
+	}
+	// Items trashed before deletes checked for held stock may still have some
+	if err := ensureNotHeld(item); err != nil {
+		return err
+	}
+	for _, reference := range itemReferences {
+		var count int64
+		if err := tx.Model(reference.model).Where("item_id = ?", item.ID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return fmt.Errorf("%w: %d %s", ErrItemReferenced, count, reference.name)
+		}
+	}
+
+	if err := tx.Where("item_id = ? AND status <> ?", item.ID, models.ReservationActive).
+		Delete(&models.Reservation{}).Error; err != nil {
+		return err
+	}
+	dependents := []interface{}{
+		&models.ItemStock{},
+		&models.ItemSupplier{},
+		&models.AlertRule{},
+		&models.AlertState{},
+	}
+	for _, dependent := range dependents {
+		if err := tx.Where("item_id = ?", item.ID).Delete(dependent).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Unscoped().Delete(item).Error; err != nil {
+		return err
+	}
+	return RecordAudit(tx, audit, models.AuditPurge, item, nil)
+}
+
+// PurgeDeletedBefore permanently removes every item soft-deleted before cutoff and
+// reports how many were purged.
+func PurgeDeletedBefore(db *gorm.DB, cutoff time.Time) (int, error) {
+	var ids []string
+	err := db.Unscoped().Model(&models.Item{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, id := range ids {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			return PurgeItem(tx, id, SystemAudit)
+		})
+		// Restored or purged concurrently by another replica, still holding stock
+		// that has to be released or received first, or kept by its history
+		if errors.Is(err, ErrItemNotDeleted) || errors.Is(err, ErrItemHeld) || errors.Is(err, ErrItemReferenced) {
+			continue
+		}
+		if err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// ensureNotHeld refuses to delete an item whose units are reserved (by
+// reservations or sales order allocations) or in transit, since the reservations,
+// orders and transfers holding them could no longer be completed.
+func ensureNotHeld(item *models.Item) error {
+	if item.Reserved > 0 || item.InTransit > 0 {
+		return fmt.Errorf("%w: %d reserved, %d in transit", ErrItemHeld, item.Reserved, item.InTransit)
+	}
+	return nil
+}
+
+func lockTrashedItem(tx *gorm.DB, id string) (*models.Item, error) {
+	var item models.Item
+	err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("deleted_at IS NOT NULL").
+		First(&item, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrItemNotDeleted
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
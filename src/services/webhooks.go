@@ -0,0 +1,302 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"inventory-service/src/models"
+)
+
+var (
+	ErrWebhookNotFound         = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+	ErrInvalidWebhook          = errors.New("invalid webhook subscription")
+)
+
+// Headers sent with every webhook delivery.
+const (
+	WebhookEventHeader     = "X-Inventory-Event"
+	WebhookDeliveryHeader  = "X-Inventory-Delivery"
+	WebhookTimestampHeader = "X-Inventory-Timestamp"
+	WebhookSignatureHeader = "X-Inventory-Signature"
+)
+
+// WebhookClient delivers webhook events to subscribers.
+var WebhookClient = &http.Client{Timeout: 10 * time.Second}
+
+// webhookClaim is how long claimed deliveries stay hidden from other replicas; it
+// must outlast WebhookClient's timeout.
+const webhookClaim = time.Minute
+
+// A failed delivery is retried after WebhookRetryBase, doubling each time, and is
+// dead-lettered after WebhookMaxAttempts attempts.
+var (
+	WebhookRetryBase   = 30 * time.Second
+	WebhookMaxAttempts = 8
+)
+
+// SaveWebhookSubscription creates or updates a subscription after checking its
+// event filter. A subscription without a secret is given a random one.
+func SaveWebhookSubscription(tx *gorm.DB, subscription *models.WebhookSubscription) error {
+	if len(subscription.Events) == 0 {
+		return fmt.Errorf("%w: events must not be empty", ErrInvalidWebhook)
+	}
+	seen := map[string]bool{}
+	events := models.StringList{}
+	for _, eventType := range subscription.Events {
+		if !models.ValidEventType(eventType) {
+			return fmt.Errorf("%w: unknown event %q", ErrInvalidWebhook, eventType)
+		}
+		if !seen[eventType] {
+			seen[eventType] = true
+			events = append(events, eventType)
+		}
+	}
+	subscription.Events = events
+
+	if subscription.Secret == "" {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return err
+		}
+		subscription.Secret = hex.EncodeToString(secret)
+	}
+
+	if subscription.ID == "" {
+		return tx.Create(subscription).Error
+	}
+	return tx.Save(subscription).Error
+}
+
+// RedeliverWebhook queues a new delivery of the same event and payload as an
+// earlier delivery to the subscription, whatever that delivery's outcome was.
+func RedeliverWebhook(tx *gorm.DB, subscriptionID, deliveryID string) (*models.WebhookDelivery, error) {
+	var original models.WebhookDelivery
+	err := tx.Where("subscription_id = ?", subscriptionID).First(&original, "id = ?", deliveryID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	delivery := models.WebhookDelivery{
+		SubscriptionID: original.SubscriptionID,
+		EventID:        original.EventID,
+		EventType:      original.EventType,
+		Payload:        original.Payload,
+		Status:         models.WebhookPending,
+		NextAttemptAt:  &now,
+	}
+	if err := tx.Create(&delivery).Error; err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// SignWebhook returns the signature header value for a delivery: the hex HMAC-SHA256
+// of "<timestamp>.<body>" keyed with the subscription secret, prefixed with
+// "sha256=". Receivers recompute it and reject stale timestamps.
+func SignWebhook(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// DeliverWebhooks attempts every pending delivery that is due and reports how many
+// were attempted. Deliveries are claimed in a short transaction and sent after it
+// commits, so slow receivers never hold row locks. Failures are rescheduled with
+// exponential backoff until WebhookMaxAttempts is reached. Deliveries claimed by
+// another replica are skipped, as are deliveries to disabled subscriptions.
+func DeliverWebhooks(ctx context.Context, db *gorm.DB) (int, error) {
+	deliveries, err := claimWebhookDeliveries(db)
+	if err != nil || len(deliveries) == 0 {
+		return 0, err
+	}
+
+	subscriptionIDs := make([]string, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		subscriptionIDs = append(subscriptionIDs, delivery.SubscriptionID)
+	}
+	var subscriptions []models.WebhookSubscription
+	if err := db.Where("id IN ?", subscriptionIDs).Find(&subscriptions).Error; err != nil {
+		return 0, err
+	}
+	byID := map[string]models.WebhookSubscription{}
+	for _, subscription := range subscriptions {
+		byID[subscription.ID] = subscription
+	}
+
+	// Receivers are independent, so one slow receiver must not delay the rest
+	var wg sync.WaitGroup
+	for i := range deliveries {
+		wg.Add(1)
+		go func(delivery *models.WebhookDelivery) {
+			defer wg.Done()
+			attemptWebhook(ctx, byID[delivery.SubscriptionID], delivery)
+		}(&deliveries[i])
+	}
+	wg.Wait()
+
+	// Attempts cut short by shutdown are not counted; their claim runs out and
+	// they are sent again
+	if ctx.Err() != nil {
+		return 0, ctx.Err()
+	}
+
+	attempted := 0
+	for i := range deliveries {
+		if err := recordWebhookAttempt(db, &deliveries[i]); err != nil {
+			return attempted, err
+		}
+		attempted++
+	}
+	return attempted, nil
+}
+
+// claimWebhookDeliveries picks up to 50 due deliveries and moves their next attempt
+// past webhookClaim, hiding them from other replicas while they are sent. Should
+// this replica die before recording the outcome, they are retried afterwards.
+func claimWebhookDeliveries(db *gorm.DB) ([]models.WebhookDelivery, error) {
+	var deliveries []models.WebhookDelivery
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{
+			Strength: "UPDATE",
+			Table:    clause.Table{Name: "webhook_deliveries"},
+			Options:  "SKIP LOCKED",
+		}).
+			Joins("JOIN webhook_subscriptions ON webhook_subscriptions.id = webhook_deliveries.subscription_id").
+			Where("webhook_deliveries.status = ? AND webhook_deliveries.next_attempt_at <= ?", models.WebhookPending, time.Now()).
+			Where("webhook_subscriptions.enabled").
+			Order("webhook_deliveries.next_attempt_at").
+			Limit(50).
+			Find(&deliveries).Error
+		if err != nil || len(deliveries) == 0 {
+			return err
+		}
+
+		ids := make([]string, 0, len(deliveries))
+		for _, delivery := range deliveries {
+			ids = append(ids, delivery.ID)
+		}
+		return tx.Model(&models.WebhookDelivery{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", time.Now().Add(webhookClaim)).Error
+	})
+	return deliveries, err
+}
+
+// attemptWebhook sends a delivery and applies the outcome to it: delivered on a 2xx
+// response, otherwise rescheduled with backoff, or dead-lettered once it has failed
+// WebhookMaxAttempts times.
+func attemptWebhook(ctx context.Context, subscription models.WebhookSubscription, delivery *models.WebhookDelivery) {
+	status, sendErr := sendWebhook(ctx, subscription, delivery)
+
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.ResponseStatus = status
+	delivery.LastError = ""
+	switch {
+	case sendErr == nil:
+		delivery.Status = models.WebhookDelivered
+		delivery.DeliveredAt = &now
+		delivery.NextAttemptAt = nil
+	case delivery.Attempts >= WebhookMaxAttempts:
+		delivery.Status = models.WebhookDeadLetter
+		delivery.LastError = sendErr.Error()
+		delivery.NextAttemptAt = nil
+	default:
+		delivery.LastError = sendErr.Error()
+		next := now.Add(webhookBackoff(delivery.Attempts))
+		delivery.NextAttemptAt = &next
+	}
+}
+
+// recordWebhookAttempt stores the outcome of an attempt on its delivery.
+func recordWebhookAttempt(db *gorm.DB, delivery *models.WebhookDelivery) error {
+	return db.Model(delivery).
+		Select("status", "attempts", "next_attempt_at", "last_attempt_at", "response_status", "last_error", "delivered_at").
+		Updates(delivery).Error
+}
+
+// enqueueWebhooks queues a delivery of event to every enabled subscription that
+// asked for its type.
+func enqueueWebhooks(tx *gorm.DB, event *models.OutboxEvent) error {
+	filter, err := json.Marshal([]string{event.Type})
+	if err != nil {
+		return err
+	}
+	var subscriptions []models.WebhookSubscription
+	err = tx.Where("enabled AND events @> ?::jsonb", string(filter)).Find(&subscriptions).Error
+	if err != nil || len(subscriptions) == 0 {
+		return err
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	deliveries := make([]models.WebhookDelivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		deliveries = append(deliveries, models.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Payload:        models.JSON(payload),
+			Status:         models.WebhookPending,
+			NextAttemptAt:  &now,
+		})
+	}
+	return tx.Create(&deliveries).Error
+}
+
+// sendWebhook POSTs a delivery's payload and returns the response status. Anything
+// but a 2xx response is a failure.
+func sendWebhook(ctx context.Context, subscription models.WebhookSubscription, delivery *models.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	timestamp := time.Now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, delivery.EventType)
+	req.Header.Set(WebhookDeliveryHeader, delivery.ID)
+	req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(WebhookSignatureHeader, SignWebhook(subscription.Secret, timestamp, body))
+
+	resp, err := WebhookClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// webhookBackoff is the wait before the attempt after the given number of failures.
+func webhookBackoff(attempts int) time.Duration {
+	return WebhookRetryBase << (attempts - 1)
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"inventory-service/src/models"
+)
+
+const testSecret = "s3cret"
+
+// receiver starts a webhook receiver that answers every request with status and
+// fails the test when the signature header does not verify.
+func receiver(t *testing.T, status int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("reading body: %v", err)
+		}
+		mac := hmac.New(sha256.New, []byte(testSecret))
+		mac.Write([]byte(r.Header.Get(WebhookTimestampHeader) + "." + string(body)))
+		want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+		if got := r.Header.Get(WebhookSignatureHeader); !hmac.Equal([]byte(got), []byte(want)) {
+			t.Errorf("signature = %q, want %q", got, want)
+		}
+		if got := r.Header.Get(WebhookEventHeader); got != models.EventItemUpdated {
+			t.Errorf("event header = %q, want %q", got, models.EventItemUpdated)
+		}
+		if got := r.Header.Get(WebhookDeliveryHeader); got != "delivery-1" {
+			t.Errorf("delivery header = %q, want %q", got, "delivery-1")
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func pendingDelivery(attempts int) *models.WebhookDelivery {
+	return &models.WebhookDelivery{
+		ID:        "delivery-1",
+		EventType: models.EventItemUpdated,
+		Payload:   models.JSON(`{"id":"event-1","type":"item.updated"}`),
+		Status:    models.WebhookPending,
+		Attempts:  attempts,
+	}
+}
+
+func TestAttemptWebhookDelivered(t *testing.T) {
+	server := receiver(t, http.StatusNoContent)
+	subscription := models.WebhookSubscription{URL: server.URL, Secret: testSecret}
+	delivery := pendingDelivery(0)
+
+	attemptWebhook(context.Background(), subscription, delivery)
+
+	if delivery.Status != models.WebhookDelivered {
+		t.Fatalf("status = %q, want %q", delivery.Status, models.WebhookDelivered)
+	}
+	if delivery.Attempts != 1 || delivery.ResponseStatus != http.StatusNoContent {
+		t.Errorf("attempts = %d, response = %d", delivery.Attempts, delivery.ResponseStatus)
+	}
+	if delivery.DeliveredAt == nil || delivery.NextAttemptAt != nil {
+		t.Errorf("delivered_at = %v, next_attempt_at = %v", delivery.DeliveredAt, delivery.NextAttemptAt)
+	}
+}
+
+func TestAttemptWebhookBacksOff(t *testing.T) {
+	server := receiver(t, http.StatusServiceUnavailable)
+	subscription := models.WebhookSubscription{URL: server.URL, Secret: testSecret}
+	delivery := pendingDelivery(0)
+
+	for attempt := 1; attempt <= 3; attempt++ {
+		before := time.Now()
+		attemptWebhook(context.Background(), subscription, delivery)
+
+		if delivery.Status != models.WebhookPending {
+			t.Fatalf("attempt %d: status = %q, want %q", attempt, delivery.Status, models.WebhookPending)
+		}
+		if delivery.Attempts != attempt || delivery.ResponseStatus != http.StatusServiceUnavailable {
+			t.Errorf("attempt %d: attempts = %d, response = %d", attempt, delivery.Attempts, delivery.ResponseStatus)
+		}
+		if delivery.LastError == "" {
+			t.Errorf("attempt %d: last_error is empty", attempt)
+		}
+		wait := WebhookRetryBase << (attempt - 1)
+		if delivery.NextAttemptAt == nil || delivery.NextAttemptAt.Before(before.Add(wait)) ||
+			delivery.NextAttemptAt.After(time.Now().Add(wait)) {
+			t.Errorf("attempt %d: next_attempt_at = %v, want about %v from now", attempt, delivery.NextAttemptAt, wait)
+		}
+	}
+}
+
+func TestAttemptWebhookDeadLetters(t *testing.T) {
+	server := receiver(t, http.StatusInternalServerError)
+	subscription := models.WebhookSubscription{URL: server.URL, Secret: testSecret}
+	delivery := pendingDelivery(WebhookMaxAttempts - 1)
+
+	attemptWebhook(context.Background(), subscription, delivery)
+
+	if delivery.Status != models.WebhookDeadLetter {
+		t.Fatalf("status = %q, want %q", delivery.Status, models.WebhookDeadLetter)
+	}
+	if delivery.Attempts != WebhookMaxAttempts || delivery.NextAttemptAt != nil || delivery.DeliveredAt != nil {
+		t.Errorf("attempts = %d, next_attempt_at = %v, delivered_at = %v",
+			delivery.Attempts, delivery.NextAttemptAt, delivery.DeliveredAt)
+	}
+}
@@ -21,7 +21,7 @@ func ConnectDatabase() *gorm.DB {
 		log.Fatal("DATABASE_URL environment variable is required")
 	}
 
-	connection, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	connection, err := gorm.Open(postgres.Open(dsn), &gorm.Config{TranslateError: true})
 	if err != nil {
 		log.Fatalf("failed to connect database: %v", err)
 	}